@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"html/template"
+	"iter"
 )
 
 type (
@@ -55,13 +56,15 @@ func (c *Client) CategoriesSearch(filter map[string]string) (*CategoriesSearchRe
 }
 
 // Categories 'iterable' by filtered store categories
+//
+// Deprecated: errors are silently dropped, use CategoriesIterator or CategoriesSeq instead
 func (c *Client) Categories(ctx context.Context, filter map[string]string) <-chan *Category {
 	catChan := make(chan *Category)
 
 	go func() {
 		defer close(catChan)
 
-		c.CategoriesTrampoline(filter, func(index uint, category *Category) error {
+		c.WithContext(ctx).CategoriesTrampoline(filter, func(index uint, category *Category) error {
 			// FIXME silent error. maybe catChan <- nil ?
 			select {
 			case <-ctx.Done():
@@ -75,6 +78,24 @@ func (c *Client) Categories(ctx context.Context, filter map[string]string) <-cha
 	return catChan
 }
 
+// CategoriesIterator iterates by filtered store categories
+func (c *Client) CategoriesIterator(filter map[string]string) *Iterator[*Category] {
+	return newIterator(filter, c.categoriesPage)
+}
+
+// CategoriesSeq iterates by filtered store categories with range-over-func
+func (c *Client) CategoriesSeq(ctx context.Context, filter map[string]string) iter.Seq2[*Category, error] {
+	return c.CategoriesIterator(filter).Seq(ctx)
+}
+
+func (c *Client) categoriesPage(ctx context.Context, filter map[string]string) ([]*Category, *SearchResponse, error) {
+	resp, err := c.WithContext(ctx).CategoriesSearch(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Items, &resp.SearchResponse, nil
+}
+
 // CategoryGet gets all details of a specific category in an Ecwid store by its ID
 func (c *Client) CategoryGet(categoryID ID) (*Category, error) {
 	response, err := c.R().
@@ -0,0 +1,63 @@
+package ecwid
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type (
+	// Cursor is a serialisable position of paginated search:
+	// search filter without offset plus offset of the next item.
+	// Persist it as json and resume with Query:
+	//
+	//	client.OrdersTrampoline(cursor.Query(), fn)
+	Cursor struct {
+		Filter map[string]string `json:"filter,omitempty"`
+		Offset uint              `json:"offset"`
+	}
+
+	// PaginationError is returned by trampolines
+	// with Cursor of the first not processed item
+	PaginationError struct {
+		Cursor Cursor
+		Err    error
+	}
+)
+
+func newCursor(filter map[string]string, offset uint) Cursor {
+	filterCopy := copyFilter(filter)
+	delete(filterCopy, "offset")
+
+	return Cursor{
+		Filter: filterCopy,
+		Offset: offset,
+	}
+}
+
+// filterOffset returns offset of filter, 0 if not set
+func filterOffset(filter map[string]string) uint {
+	offset, err := strconv.ParseUint(filter["offset"], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return uint(offset)
+}
+
+// Query returns search filter resuming from cursor
+func (c Cursor) Query() map[string]string {
+	filter := copyFilter(c.Filter)
+	if c.Offset > 0 {
+		filter["offset"] = fmt.Sprintf("%d", c.Offset)
+	}
+	return filter
+}
+
+// Error implements error
+func (e *PaginationError) Error() string {
+	return fmt.Sprintf("%v (at offset %d)", e.Err, e.Cursor.Offset)
+}
+
+// Unwrap returns underlying error
+func (e *PaginationError) Unwrap() error {
+	return e.Err
+}
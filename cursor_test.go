@@ -0,0 +1,76 @@
+package ecwid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CursorTestSuite struct {
+	suite.Suite
+}
+
+func TestCursorTestSuite(t *testing.T) {
+	suite.Run(t, new(CursorTestSuite))
+}
+
+func (suite *CursorTestSuite) TestIteratorCursor() {
+	filter := map[string]string{"limit": "2", "offset": "1"}
+	it := newIterator(filter, pages(9, 2, 5))
+
+	actual := make([]int, 0, 9)
+	for it.Next(context.Background()) {
+		actual = append(actual, it.Item())
+	}
+	suite.EqualError(it.Err(), "fail")
+	suite.Equal([]int{1, 2, 3, 4}, actual)
+
+	cursor := it.Cursor()
+	suite.Equal(Cursor{Filter: map[string]string{"limit": "2"}, Offset: 5}, cursor)
+
+	data, err := json.Marshal(cursor)
+	suite.Nil(err)
+	var restored Cursor
+	suite.Nil(json.Unmarshal(data, &restored))
+	suite.Equal(cursor, restored)
+
+	it = newIterator(restored.Query(), pages(9, 2, -1))
+	actual = actual[:0]
+	for it.Next(context.Background()) {
+		actual = append(actual, it.Item())
+	}
+	suite.Nil(it.Err())
+	suite.Equal([]int{5, 6, 7, 8}, actual, "resumed")
+}
+
+func (suite *CursorTestSuite) TestTrampolineCursor() {
+	stop := errors.New("stop")
+
+	for _, prefetch := range []int{0, 3} {
+		err := searchTrampoline(context.Background(), prefetch, map[string]string{"offset": "2"}, pages(20, 3, -1),
+			func(index uint, item int) error {
+				if item == 10 {
+					return stop
+				}
+				return nil
+			})
+
+		var pagErr *PaginationError
+		suite.True(errors.As(err, &pagErr), "prefetch %d", prefetch)
+		suite.True(errors.Is(err, stop))
+		suite.Equal(uint(10), pagErr.Cursor.Offset, "failed item offset")
+		suite.Equal("10", pagErr.Cursor.Query()["offset"])
+	}
+
+	err := searchTrampoline(context.Background(), 0, nil, pages(20, 3, 9),
+		func(index uint, item int) error {
+			return nil
+		})
+
+	var pagErr *PaginationError
+	suite.True(errors.As(err, &pagErr))
+	suite.Equal(uint(9), pagErr.Cursor.Offset, "failed page offset")
+}
@@ -0,0 +1,128 @@
+package ecwid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+type (
+	// NewCustomer https://developers.ecwid.com/api-documentation/customers#create-customer
+	NewCustomer struct {
+		Email             string            `json:"email,omitempty"` // mandatory for CustomerAdd
+		Password          string            `json:"password,omitempty"`
+		CustomerGroupID   ID                `json:"customerGroupId,omitempty"`
+		BillingPerson     *PersonInfo       `json:"billingPerson,omitempty"`
+		ShippingAddresses []ShippingAddress `json:"shippingAddresses,omitempty"`
+		TaxID             string            `json:"taxId,omitempty"`
+		TaxIDValid        bool              `json:"taxIdValid,omitempty"`
+		TaxExempt         bool              `json:"taxExempt,omitempty"`
+		AcceptMarketing   bool              `json:"acceptMarketing,omitempty"`
+		Lang              string            `json:"lang,omitempty"`
+		PrivateAdminNotes string            `json:"privateAdminNotes,omitempty"`
+	}
+
+	// Customer https://developers.ecwid.com/api-documentation/customers#get-customer
+	Customer struct {
+		NewCustomer
+		ID                ID             `json:"id"`
+		Registered        DateTime       `json:"registered"`
+		Updated           DateTime       `json:"updated"`
+		TotalOrderCount   uint           `json:"totalOrderCount"`
+		CustomerGroupName string         `json:"customerGroupName"`
+		Stats             *CustomerStats `json:"stats,omitempty"`
+	}
+
+	// ShippingAddress is customer address book entry
+	ShippingAddress struct {
+		PersonInfo
+		ID               ID     `json:"id,omitempty"`
+		DefaultAddress   bool   `json:"defaultAddress"`
+		AddressFormatted string `json:"addressFormatted,omitempty"` // read only
+	}
+
+	// CustomerStats contains customer orders statistics
+	CustomerStats struct {
+		NumberOfOrders    uint     `json:"numberOfOrders"`
+		SalesValue        Money    `json:"salesValue"`
+		AverageOrderValue Money    `json:"averageOrderValue"`
+		FirstOrderDate    DateTime `json:"firstOrderDate"`
+		LastOrderDate     DateTime `json:"lastOrderDate"`
+	}
+
+	// CustomersSearchResponse https://developers.ecwid.com/api-documentation/customers#search-customers
+	CustomersSearchResponse struct {
+		SearchResponse
+		Items []*Customer `json:"items"`
+	}
+)
+
+// CustomersSearch search or filter customers in a store
+// filter:
+// keyword name email customerGroup minOrderCount maxOrderCount
+// createdFrom createdTo updatedFrom updatedTo sortBy offset limit
+func (c *Client) CustomersSearch(filter map[string]string) (*CustomersSearchResponse, error) {
+	response, err := c.R().
+		SetQueryParams(filter).
+		Get("/customers")
+
+	var result CustomersSearchResponse
+	return &result, responseUnmarshal(response, err, &result)
+}
+
+// CustomersIterator iterates by filtered store customers
+func (c *Client) CustomersIterator(filter map[string]string) *Iterator[*Customer] {
+	return newIterator(filter, c.customersPage)
+}
+
+// CustomersSeq iterates by filtered store customers with range-over-func
+func (c *Client) CustomersSeq(ctx context.Context, filter map[string]string) iter.Seq2[*Customer, error] {
+	return c.CustomersIterator(filter).Seq(ctx)
+}
+
+func (c *Client) customersPage(ctx context.Context, filter map[string]string) ([]*Customer, *SearchResponse, error) {
+	resp, err := c.WithContext(ctx).CustomersSearch(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Items, &resp.SearchResponse, nil
+}
+
+// CustomerGet gets all details of a specific customer in an Ecwid store by its ID
+func (c *Client) CustomerGet(customerID ID) (*Customer, error) {
+	response, err := c.R().
+		Get(fmt.Sprintf("/customers/%d", customerID))
+
+	var result Customer
+	return &result, responseUnmarshal(response, err, &result)
+}
+
+// CustomerAdd creates a new customer in an Ecwid store
+// returns new customerId
+func (c *Client) CustomerAdd(customer *NewCustomer) (ID, error) {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(customer).
+		Post("/customers")
+
+	return responseAdd(response, err)
+}
+
+// CustomerUpdate update an existing customer in an Ecwid store referring to its ID
+func (c *Client) CustomerUpdate(customerID ID, customer *NewCustomer) error {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(customer).
+		Put(fmt.Sprintf("/customers/%d", customerID))
+
+	return responseUpdate(response, err)
+}
+
+// CustomerDelete delete a customer from an Ecwid store referring to its ID
+func (c *Client) CustomerDelete(customerID ID) error {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/customers/%d", customerID))
+
+	_, err = responseDelete(response, err)
+	return err
+}
@@ -0,0 +1,80 @@
+package ecwid
+
+import "fmt"
+
+type (
+	// CustomerGroup https://developers.ecwid.com/api-documentation/customer-groups#get-customer-group
+	CustomerGroup struct {
+		ID   ID     `json:"id,omitempty"` // 0 is General group
+		Name string `json:"name,omitempty"`
+	}
+
+	// CustomerGroupsSearchResponse https://developers.ecwid.com/api-documentation/customer-groups#search-customer-groups
+	CustomerGroupsSearchResponse struct {
+		SearchResponse
+		Items []*CustomerGroup `json:"items"`
+	}
+)
+
+// CustomerGroupsSearch search customer groups in a store
+// filter:
+// keyword offset limit
+func (c *Client) CustomerGroupsSearch(filter map[string]string) (*CustomerGroupsSearchResponse, error) {
+	response, err := c.R().
+		SetQueryParams(filter).
+		Get("/customer_groups")
+
+	var result CustomerGroupsSearchResponse
+	return &result, responseUnmarshal(response, err, &result)
+}
+
+// CustomerGroupGet gets the details of a specific customer group referring to its ID
+func (c *Client) CustomerGroupGet(customerGroupID ID) (*CustomerGroup, error) {
+	response, err := c.R().
+		Get(fmt.Sprintf("/customer_groups/%d", customerGroupID))
+
+	var result CustomerGroup
+	return &result, responseUnmarshal(response, err, &result)
+}
+
+// CustomerGroupAdd creates a new customer group in an Ecwid store
+// returns new customerGroupId
+func (c *Client) CustomerGroupAdd(customerGroup *CustomerGroup) (ID, error) {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(customerGroup).
+		Post("/customer_groups")
+
+	return responseAdd(response, err)
+}
+
+// CustomerGroupUpdate updates the name of a specific customer group referring to its ID
+func (c *Client) CustomerGroupUpdate(customerGroupID ID, customerGroup *CustomerGroup) error {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(customerGroup).
+		Put(fmt.Sprintf("/customer_groups/%d", customerGroupID))
+
+	return responseUpdate(response, err)
+}
+
+// CustomerGroupDelete deletes a specific customer group.
+// Customers of the group are moved to the General group
+func (c *Client) CustomerGroupDelete(customerGroupID ID) error {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/customer_groups/%d", customerGroupID))
+
+	_, err = responseDelete(response, err)
+	return err
+}
+
+// CustomerGroupMove moves a customer to a customer group,
+// customerGroupID 0 moves to the General group
+func (c *Client) CustomerGroupMove(customerID, customerGroupID ID) error {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(fmt.Sprintf(`{"customerGroupId":%d}`, customerGroupID)).
+		Put(fmt.Sprintf("/customers/%d", customerID))
+
+	return responseUpdate(response, err)
+}
@@ -0,0 +1,192 @@
+package ecwid
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomerGroupTestSuite struct {
+	ClientTestSuite
+}
+
+func TestCustomerGroupTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomerGroupTestSuite))
+}
+
+func (suite *CustomerGroupTestSuite) TestCustomerGroupsSearch() {
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customer_groups", storeID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("GET", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("10", req.URL.Query().Get("limit"), "limit")
+
+			return httpmock.NewStringResponse(200, `{"total":2,"count":2,"items":[{"id":1,"name":"Wholesale"},{"id":2,"name":"Retail"}]}`), nil
+		})
+
+	resp, err := suite.client.CustomerGroupsSearch(map[string]string{"limit": "10"})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Len(resp.Items, 2)
+	suite.Equal("Wholesale", resp.Items[0].Name, "name")
+}
+
+func (suite *CustomerGroupTestSuite) TestCustomerGroupGet() {
+	const (
+		customerGroupID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customer_groups/%d", storeID, customerGroupID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("GET", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d,"name":"Wholesale"}`, customerGroupID)), nil
+		})
+
+	group, err := suite.client.CustomerGroupGet(customerGroupID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(customerGroupID, group.ID, "id")
+	suite.Equal("Wholesale", group.Name, "name")
+}
+
+func (suite *CustomerGroupTestSuite) TestCustomerGroupAdd() {
+	const (
+		customerGroupID ID = 999
+		name               = "Wholesale"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customer_groups", storeID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("POST", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			suite.Equal(`{"name":"Wholesale"}`, string(body))
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, customerGroupID)), nil
+		})
+
+	id, err := suite.client.CustomerGroupAdd(&CustomerGroup{Name: name})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(customerGroupID, id, "id")
+}
+
+func (suite *CustomerGroupTestSuite) TestCustomerGroupUpdate() {
+	const (
+		customerGroupID ID = 999
+		name               = "Wholesale"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customer_groups/%d", storeID, customerGroupID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("PUT", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			var g CustomerGroup
+			err = json.Unmarshal(body, &g)
+			suite.Nil(err)
+			suite.Equal(name, g.Name, "name")
+
+			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
+		})
+
+	err := suite.client.CustomerGroupUpdate(customerGroupID, &CustomerGroup{Name: name})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
+
+func (suite *CustomerGroupTestSuite) TestCustomerGroupDelete() {
+	const (
+		customerGroupID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customer_groups/%d", storeID, customerGroupID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":1}`), nil
+		})
+
+	err := suite.client.CustomerGroupDelete(customerGroupID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
+
+func (suite *CustomerGroupTestSuite) TestCustomerGroupMove() {
+	const (
+		customerID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customers/%d", storeID, customerID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("PUT", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			suite.Equal(`{"customerGroupId":0}`, string(body), "General group must be sent")
+
+			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
+		})
+
+	err := suite.client.CustomerGroupMove(customerID, 0)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
@@ -0,0 +1,206 @@
+package ecwid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomerTestSuite struct {
+	ClientTestSuite
+}
+
+func TestCustomerTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomerTestSuite))
+}
+
+func (suite *CustomerTestSuite) TestCustomersSearchRequest() {
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customers", storeID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("GET", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			values := req.URL.Query()
+			suite.Equal("john", values.Get("keyword"), "keyword")
+			suite.Equal("5", values.Get("limit"), "limit")
+
+			return httpmock.NewStringResponse(200, `{"total":1,"count":1,"items":[{"id":1,"email":"john@example.com"}]}`), nil
+		})
+
+	resp, err := suite.client.CustomersSearch(map[string]string{
+		"keyword": "john",
+		"limit":   "5",
+	})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal("john@example.com", resp.Items[0].Email, "email")
+}
+
+func (suite *CustomerTestSuite) TestCustomersSeq() {
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			offset := req.URL.Query().Get("offset")
+			if offset == "" {
+				offset = "0"
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"total":2,"count":1,"offset":%s,"items":[{"id":%s}]}`, offset, offset)), nil
+		})
+
+	ids := make([]ID, 0, 2)
+	for customer, err := range suite.client.CustomersSeq(context.Background(), nil) {
+		suite.Nil(err)
+		ids = append(ids, customer.ID)
+	}
+	suite.Equal([]ID{0, 1}, ids)
+}
+
+func (suite *CustomerTestSuite) TestCustomerGet() {
+	const (
+		customerID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customers/%d", storeID, customerID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("GET", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{
+				"id":%d,
+				"email":"john@example.com",
+				"customerGroupId":3,
+				"billingPerson":{"name":"John Doe"},
+				"shippingAddresses":[{"id":5,"name":"John Doe","city":"Paris","defaultAddress":true}],
+				"taxId":"FR123",
+				"acceptMarketing":true,
+				"stats":{"numberOfOrders":2,"salesValue":20.5}
+			}`, customerID)), nil
+		})
+
+	customer, err := suite.client.CustomerGet(customerID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(customerID, customer.ID, "id")
+	suite.Equal(ID(3), customer.CustomerGroupID, "customer group")
+	suite.Equal("John Doe", customer.BillingPerson.Name, "billing person")
+	suite.Equal("Paris", customer.ShippingAddresses[0].City, "shipping address")
+	suite.True(customer.ShippingAddresses[0].DefaultAddress, "default address")
+	suite.Equal("FR123", customer.TaxID, "tax id")
+	suite.True(customer.AcceptMarketing, "accept marketing")
+	suite.Equal(uint(2), customer.Stats.NumberOfOrders, "stats")
+	suite.Equal("20.5", customer.Stats.SalesValue.String(), "stats")
+}
+
+func (suite *CustomerTestSuite) TestCustomerAdd() {
+	const (
+		customerID ID = 999
+		email         = "john@example.com"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customers", storeID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("POST", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			var c NewCustomer
+			err = json.Unmarshal(body, &c)
+			suite.Nil(err)
+			suite.Equal(email, c.Email, "email")
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, customerID)), nil
+		})
+
+	id, err := suite.client.CustomerAdd(&NewCustomer{Email: email})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(customerID, id, "id")
+}
+
+func (suite *CustomerTestSuite) TestCustomerUpdate() {
+	const (
+		customerID ID = 999
+		email         = "john@example.com"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customers/%d", storeID, customerID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("PUT", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			var c NewCustomer
+			err = json.Unmarshal(body, &c)
+			suite.Nil(err)
+			suite.Equal(email, c.Email, "email")
+
+			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
+		})
+
+	err := suite.client.CustomerUpdate(customerID, &NewCustomer{Email: email})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
+
+func (suite *CustomerTestSuite) TestCustomerDelete() {
+	const (
+		customerID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/customers/%d", storeID, customerID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":1}`), nil
+		})
+
+	err := suite.client.CustomerDelete(customerID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
@@ -0,0 +1,110 @@
+package ecwid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+type (
+	// NewDiscountCoupon https://developers.ecwid.com/api-documentation/discount-coupons#create-discount-coupon
+	NewDiscountCoupon struct {
+		Name             string                      `json:"name,omitempty"` // mandatory for DiscountCouponAdd
+		Code             string                      `json:"code,omitempty"` // mandatory for DiscountCouponAdd
+		Type             DiscountCouponType          `json:"discountType,omitempty"`
+		Status           DiscountCouponStatus        `json:"status,omitempty"`
+		Discount         Money                       `json:"discount,omitzero"`
+		LaunchDate       DateTime                    `json:"launchDate,omitzero"`
+		ExpirationDate   DateTime                    `json:"expirationDate,omitzero"`
+		TotalLimit       Money                       `json:"totalLimit,omitzero"`
+		UsesLimit        DiscountCouponUseLimit      `json:"usesLimit,omitempty"`
+		ApplicationLimit string                      `json:"applicationLimit,omitempty"` // UNLIMITED, NEW_CUSTOMER_ONLY, REPEAT_CUSTOMER_ONLY
+		CatalogLimit     *DiscountCouponCatalogLimit `json:"catalogLimit,omitempty"`
+	}
+
+	// DiscountCoupon https://developers.ecwid.com/api-documentation/discount-coupons#get-discount-coupon
+	DiscountCoupon struct {
+		NewDiscountCoupon
+		ID           ID       `json:"id"`
+		CreationDate DateTime `json:"creationDate"`
+		UpdateDate   DateTime `json:"updateDate"`
+		OrderCount   uint     `json:"orderCount"`
+	}
+
+	// DiscountCouponsSearchResponse https://developers.ecwid.com/api-documentation/discount-coupons#search-discount-coupons
+	DiscountCouponsSearchResponse struct {
+		SearchResponse
+		Items []*DiscountCoupon `json:"items"`
+	}
+)
+
+// DiscountCouponsSearch search or filter discount coupons in a store
+// filter:
+// code discount_type availability createdFrom createdTo updatedFrom updatedTo offset limit
+// see DiscountCouponFilter
+func (c *Client) DiscountCouponsSearch(filter map[string]string) (*DiscountCouponsSearchResponse, error) {
+	response, err := c.R().
+		SetQueryParams(filter).
+		Get("/discount_coupons")
+
+	var result DiscountCouponsSearchResponse
+	return &result, responseUnmarshal(response, err, &result)
+}
+
+// DiscountCouponsIterator iterates by filtered store discount coupons
+func (c *Client) DiscountCouponsIterator(filter map[string]string) *Iterator[*DiscountCoupon] {
+	return newIterator(filter, c.discountCouponsPage)
+}
+
+// DiscountCouponsSeq iterates by filtered store discount coupons with range-over-func
+func (c *Client) DiscountCouponsSeq(ctx context.Context, filter map[string]string) iter.Seq2[*DiscountCoupon, error] {
+	return c.DiscountCouponsIterator(filter).Seq(ctx)
+}
+
+func (c *Client) discountCouponsPage(ctx context.Context, filter map[string]string) ([]*DiscountCoupon, *SearchResponse, error) {
+	resp, err := c.WithContext(ctx).DiscountCouponsSearch(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Items, &resp.SearchResponse, nil
+}
+
+// DiscountCouponGet gets all details of a specific discount coupon by its ID
+func (c *Client) DiscountCouponGet(couponID ID) (*DiscountCoupon, error) {
+	response, err := c.R().
+		Get(fmt.Sprintf("/discount_coupons/%d", couponID))
+
+	var result DiscountCoupon
+	return &result, responseUnmarshal(response, err, &result)
+}
+
+// DiscountCouponAdd creates a new discount coupon in an Ecwid store
+// returns new couponId
+func (c *Client) DiscountCouponAdd(coupon *NewDiscountCoupon) (ID, error) {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(coupon).
+		Post("/discount_coupons")
+
+	return responseAdd(response, err)
+}
+
+// DiscountCouponUpdate update an existing discount coupon referring to its ID,
+// e.g. set Status to DiscountCouponExpired
+func (c *Client) DiscountCouponUpdate(couponID ID, coupon *NewDiscountCoupon) error {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(coupon).
+		Put(fmt.Sprintf("/discount_coupons/%d", couponID))
+
+	return responseUpdate(response, err)
+}
+
+// DiscountCouponDelete delete a discount coupon from an Ecwid store referring to its ID
+func (c *Client) DiscountCouponDelete(couponID ID) error {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/discount_coupons/%d", couponID))
+
+	_, err = responseDelete(response, err)
+	return err
+}
@@ -0,0 +1,59 @@
+package ecwid
+
+import (
+	"fmt"
+	"time"
+)
+
+// DiscountCouponFilter builds filter of DiscountCouponsSearch, DiscountCouponsIterator, etc.
+// It is a map[string]string, so it can be passed anywhere a filter is expected:
+//
+//	client.DiscountCouponsSearch(ecwid.NewDiscountCouponFilter().Status(ecwid.DiscountCouponActive))
+type DiscountCouponFilter map[string]string
+
+// NewDiscountCouponFilter creates empty DiscountCouponFilter
+func NewDiscountCouponFilter() DiscountCouponFilter {
+	return make(DiscountCouponFilter)
+}
+
+// Code sets discount coupon code
+func (f DiscountCouponFilter) Code(code string) DiscountCouponFilter {
+	f["code"] = code
+	return f
+}
+
+// Type sets discount type
+func (f DiscountCouponFilter) Type(discountType DiscountCouponType) DiscountCouponFilter {
+	f["discount_type"] = string(discountType)
+	return f
+}
+
+// Status sets discount coupon status
+func (f DiscountCouponFilter) Status(status DiscountCouponStatus) DiscountCouponFilter {
+	f["availability"] = string(status)
+	return f
+}
+
+// Created sets discount coupon creation date range, zero bound is omitted
+func (f DiscountCouponFilter) Created(from, to time.Time) DiscountCouponFilter {
+	setTimeRange(f, "createdFrom", "createdTo", from, to)
+	return f
+}
+
+// Updated sets discount coupon last update date range, zero bound is omitted
+func (f DiscountCouponFilter) Updated(from, to time.Time) DiscountCouponFilter {
+	setTimeRange(f, "updatedFrom", "updatedTo", from, to)
+	return f
+}
+
+// Offset sets offset from the beginning of the returned items list
+func (f DiscountCouponFilter) Offset(offset uint) DiscountCouponFilter {
+	f["offset"] = fmt.Sprintf("%d", offset)
+	return f
+}
+
+// Limit sets maximum number of returned items, maximum allowed value is 100
+func (f DiscountCouponFilter) Limit(limit uint) DiscountCouponFilter {
+	f["limit"] = fmt.Sprintf("%d", limit)
+	return f
+}
@@ -0,0 +1,37 @@
+package ecwid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DiscountCouponFilterTestSuite struct {
+	suite.Suite
+}
+
+func TestDiscountCouponFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(DiscountCouponFilterTestSuite))
+}
+
+func (suite *DiscountCouponFilterTestSuite) TestDiscountCouponFilter() {
+	created := time.Date(2015, 9, 20, 19, 59, 43, 0, time.UTC)
+
+	filter := NewDiscountCouponFilter().
+		Code("SALE").
+		Type(DiscountCouponPercent).
+		Status(DiscountCouponPaused).
+		Created(created, time.Time{}).
+		Offset(100).
+		Limit(50)
+
+	suite.Equal(DiscountCouponFilter{
+		"code":          "SALE",
+		"discount_type": "PERCENT",
+		"availability":  "PAUSED",
+		"createdFrom":   "1442779183",
+		"offset":        "100",
+		"limit":         "50",
+	}, filter)
+}
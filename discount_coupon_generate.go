@@ -0,0 +1,141 @@
+package ecwid
+
+import (
+	"crypto/rand"
+	"errors"
+	"math"
+	"math/big"
+	"sync"
+)
+
+const defaultCouponAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // without 0, O, 1, I
+
+type (
+	// CouponCodePattern describes generated coupon codes: Prefix + Length random Alphabet symbols
+	CouponCodePattern struct {
+		Prefix   string
+		Alphabet string // default is uppercase letters and digits without look-alike 0, O, 1, I
+		Length   int    // default is 8
+	}
+
+	// DiscountCouponsReport is result of DiscountCouponsGenerate
+	DiscountCouponsReport struct {
+		Created map[string]ID    // code => new coupon ID
+		Failed  map[string]error // code => error of DiscountCouponAdd
+	}
+)
+
+// Generate returns count unique codes, codes from exclude are skipped
+func (p CouponCodePattern) Generate(count int, exclude map[string]bool) ([]string, error) {
+	alphabet := []rune(p.Alphabet)
+	if len(alphabet) == 0 {
+		alphabet = []rune(defaultCouponAlphabet)
+	}
+	length := p.Length
+	if length <= 0 {
+		length = 8
+	}
+
+	// avoid endless loop if pattern space is too small
+	if space := math.Pow(float64(len(alphabet)), float64(length)); space < float64(2*(count+len(exclude))) {
+		return nil, errors.New("ecwid: coupon code pattern is too short for count")
+	}
+
+	max := big.NewInt(int64(len(alphabet)))
+	codes := make([]string, 0, count)
+	seen := make(map[string]bool, count)
+
+	for len(codes) < count {
+		code := []rune(p.Prefix)
+		for i := 0; i < length; i++ {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, err
+			}
+			code = append(code, alphabet[n.Int64()])
+		}
+
+		s := string(code)
+		if seen[s] || exclude[s] {
+			continue
+		}
+		seen[s] = true
+		codes = append(codes, s)
+	}
+
+	return codes, nil
+}
+
+// DiscountCouponsGenerate creates count coupons like template with unique codes by pattern.
+// Codes are checked against existing coupons of the store and created by concurrency requests.
+// Template Name defaults to the code.
+// Error is returned if existing coupons cannot be read, failed creations are in report
+func (c *Client) DiscountCouponsGenerate(pattern CouponCodePattern, count int, template *NewDiscountCoupon, concurrency int) (*DiscountCouponsReport, error) {
+	existing := make(map[string]bool)
+	it := c.DiscountCouponsIterator(nil)
+	for it.Next(c.Context()) {
+		existing[it.Item().Code] = true
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	codes, err := pattern.Generate(count, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	if template == nil {
+		template = &NewDiscountCoupon{}
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	report := &DiscountCouponsReport{
+		Created: make(map[string]ID, len(codes)),
+		Failed:  make(map[string]error),
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+
+	for _, code := range codes {
+		coupon := *template
+		coupon.Code = code
+		if len(coupon.Name) == 0 {
+			coupon.Name = code
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(coupon NewDiscountCoupon) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			var (
+				id  ID
+				err = c.Context().Err()
+			)
+			if err == nil {
+				id, err = c.DiscountCouponAdd(&coupon)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				report.Failed[coupon.Code] = err
+			} else {
+				report.Created[coupon.Code] = id
+			}
+		}(coupon)
+	}
+	wg.Wait()
+
+	return report, nil
+}
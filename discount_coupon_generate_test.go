@@ -0,0 +1,96 @@
+package ecwid
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiscountCouponGenerateTestSuite struct {
+	ClientTestSuite
+}
+
+func TestDiscountCouponGenerateTestSuite(t *testing.T) {
+	suite.Run(t, new(DiscountCouponGenerateTestSuite))
+}
+
+func (suite *DiscountCouponGenerateTestSuite) TestGenerate() {
+	pattern := CouponCodePattern{Prefix: "XMAS-", Alphabet: "AB", Length: 4}
+
+	codes, err := pattern.Generate(7, map[string]bool{"XMAS-AAAA": true})
+	suite.Nil(err)
+	suite.Len(codes, 7)
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		suite.True(strings.HasPrefix(code, "XMAS-"), code)
+		suite.Len(code, 9, code)
+		suite.Equal("", strings.Trim(code[5:], "AB"), code)
+		suite.NotEqual("XMAS-AAAA", code, "excluded")
+		suite.False(seen[code], "unique")
+		seen[code] = true
+	}
+
+	_, err = pattern.Generate(10, nil)
+	suite.NotNil(err, "2^4 codes is too few")
+
+	codes, err = CouponCodePattern{}.Generate(1, nil)
+	suite.Nil(err)
+	suite.Len(codes[0], 8, "default length")
+}
+
+func (suite *DiscountCouponGenerateTestSuite) TestDiscountCouponsGenerate() {
+	var (
+		mu    sync.Mutex
+		added []NewDiscountCoupon
+	)
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			if req.Method == "GET" {
+				return httpmock.NewStringResponse(200, `{"total":1,"count":1,"items":[{"id":1,"code":"A-AAAA"}]}`), nil
+			}
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			var coupon NewDiscountCoupon
+			suite.Nil(json.Unmarshal(body, &coupon))
+
+			mu.Lock()
+			defer mu.Unlock()
+			added = append(added, coupon)
+			if len(added) == 1 {
+				return httpmock.NewStringResponse(409, `{"errorMessage":"Coupon code already exists"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"id":100}`), nil
+		})
+
+	report, err := suite.client.DiscountCouponsGenerate(
+		CouponCodePattern{Prefix: "A-", Alphabet: "AB", Length: 4},
+		5,
+		&NewDiscountCoupon{Type: DiscountCouponPercent, Discount: NewMoney(10)},
+		3,
+	)
+	suite.Nil(err)
+	suite.Len(added, 5)
+	suite.Len(report.Created, 4)
+	suite.Len(report.Failed, 1)
+
+	for _, coupon := range added {
+		suite.NotEqual("A-AAAA", coupon.Code, "existing code")
+		suite.Equal(coupon.Code, coupon.Name, "default name")
+		suite.Equal(DiscountCouponPercent, coupon.Type, "template")
+	}
+	for _, err := range report.Failed {
+		var apiErr *APIError
+		suite.True(errors.As(err, &apiErr))
+		suite.Equal(409, apiErr.StatusCode)
+	}
+}
@@ -0,0 +1,180 @@
+package ecwid
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiscountCouponTestSuite struct {
+	ClientTestSuite
+}
+
+func TestDiscountCouponTestSuite(t *testing.T) {
+	suite.Run(t, new(DiscountCouponTestSuite))
+}
+
+func (suite *DiscountCouponTestSuite) TestDiscountCouponsSearch() {
+	expectedEndpoint := fmt.Sprintf(endpoint+"/discount_coupons", storeID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("GET", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			values := req.URL.Query()
+			suite.Equal("SALE", values.Get("code"), "code")
+			suite.Equal("ACTIVE", values.Get("availability"), "availability")
+
+			return httpmock.NewStringResponse(200, `{"total":1,"count":1,"items":[{"id":1,"code":"SALE","discount":10.5,"status":"ACTIVE"}]}`), nil
+		})
+
+	resp, err := suite.client.DiscountCouponsSearch(NewDiscountCouponFilter().Code("SALE").Status(DiscountCouponActive))
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal("SALE", resp.Items[0].Code, "code")
+	suite.Equal("10.5", resp.Items[0].Discount.String(), "discount")
+	suite.Equal(DiscountCouponActive, resp.Items[0].Status, "status")
+}
+
+func (suite *DiscountCouponTestSuite) TestDiscountCouponGet() {
+	const (
+		couponID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/discount_coupons/%d", storeID, couponID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("GET", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{
+				"id":%d,
+				"code":"SALE",
+				"discountType":"PERCENT",
+				"expirationDate":"2020-01-01 00:00:00 +0000",
+				"catalogLimit":{"products":[1,2]},
+				"orderCount":3
+			}`, couponID)), nil
+		})
+
+	coupon, err := suite.client.DiscountCouponGet(couponID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(couponID, coupon.ID, "id")
+	suite.Equal(DiscountCouponPercent, coupon.Type, "type")
+	suite.Equal(2020, coupon.ExpirationDate.Time().Year(), "expiration date")
+	suite.Equal([]ID{1, 2}, coupon.CatalogLimit.ProductIDs, "catalog limit")
+	suite.Equal(uint(3), coupon.OrderCount, "order count")
+}
+
+func (suite *DiscountCouponTestSuite) TestDiscountCouponAdd() {
+	const (
+		couponID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/discount_coupons", storeID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("POST", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			suite.Equal(`{"name":"Sale","code":"SALE","discountType":"ABS","discount":5}`, string(body))
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d,"code":"SALE"}`, couponID)), nil
+		})
+
+	id, err := suite.client.DiscountCouponAdd(&NewDiscountCoupon{
+		Name:     "Sale",
+		Code:     "SALE",
+		Type:     DiscountCouponAbs,
+		Discount: NewMoney(5),
+	})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(couponID, id, "id")
+}
+
+func (suite *DiscountCouponTestSuite) TestDiscountCouponUpdate() {
+	const (
+		couponID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/discount_coupons/%d", storeID, couponID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("PUT", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			var coupon NewDiscountCoupon
+			err = json.Unmarshal(body, &coupon)
+			suite.Nil(err)
+			suite.Equal(DiscountCouponExpired, coupon.Status, "status")
+
+			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
+		})
+
+	err := suite.client.DiscountCouponUpdate(couponID, &NewDiscountCoupon{Status: DiscountCouponExpired})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
+
+func (suite *DiscountCouponTestSuite) TestDiscountCouponDelete() {
+	const (
+		couponID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/discount_coupons/%d", storeID, couponID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":1}`), nil
+		})
+
+	err := suite.client.DiscountCouponDelete(couponID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
@@ -1,26 +1,92 @@
 package ecwid
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-resty/resty/v2"
 )
 
 const (
-	endpoint = "https://app.ecwid.com/api/v3/%d" // storeID
+	baseURL  = "https://app.ecwid.com/api/v3"
+	endpoint = baseURL + "/%d" // storeID
 )
 
 type (
 	// Client is Ecwid API client
 	Client struct {
 		*resty.Client
+		ctx      context.Context
+		prefetch int
 	}
 )
 
 // New method creates a new ecwid client based on resty.Client
-func New(storeID ID, token string) *Client {
-	client := resty.New().SetHostURL(fmt.Sprintf(endpoint, storeID)).SetQueryParam("token", token)
+func New(storeID ID, token string, opts ...Option) *Client {
+	o := options{
+		baseURL: baseURL,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var client *resty.Client
+	if o.httpClient != nil {
+		// copy caller's client, so its transport and timeout are not modified
+		httpClient := *o.httpClient
+		client = resty.NewWithClient(&httpClient)
+	} else {
+		client = resty.New()
+	}
+
+	client.SetHostURL(fmt.Sprintf("%s/%d", o.baseURL, storeID)).SetQueryParam("token", token)
+
+	if o.transport != nil {
+		client.SetTransport(o.transport)
+	}
+	if o.timeout > 0 {
+		client.SetTimeout(o.timeout)
+	}
+	if len(o.userAgent) > 0 {
+		client.SetHeader("User-Agent", o.userAgent)
+	}
+
+	// limiter is applied to each retry attempt
+	httpClient := client.GetClient()
+	if o.limiter != nil {
+		httpClient.Transport = newRateLimitTransport(httpClient.Transport, o.limiter)
+	}
+	if o.retry != nil {
+		httpClient.Transport = newRetryTransport(httpClient.Transport, *o.retry)
+	}
+
 	return &Client{
-		client,
+		Client: client,
+	}
+}
+
+// WithContext returns a shallow copy of the client
+// whose requests are bound to ctx.
+// The underlying resty.Client is shared with the original client.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		panic("nil context")
 	}
+	client := *c
+	client.ctx = ctx
+	return &client
+}
+
+// Context returns the client context.
+// Returns context.Background if the client has no context
+func (c *Client) Context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
+}
+
+// R creates a new request bound to the client context
+func (c *Client) R() *resty.Request {
+	return c.Client.R().SetContext(c.Context())
 }
@@ -1,9 +1,11 @@
 package ecwid
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/suite"
@@ -28,3 +30,70 @@ func (suite *EcwidTestSuite) TestRequest() {
 
 	suite.client.R().Get("/")
 }
+
+func (suite *EcwidTestSuite) TestWithContext() {
+	type key struct{}
+	var actual interface{}
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			actual = req.Context().Value(key{})
+			return httpmock.NewStringResponse(200, "{}"), nil
+		})
+
+	ctx := context.WithValue(context.Background(), key{}, "value")
+	client := suite.client.WithContext(ctx)
+	suite.Equal(ctx, client.Context())
+	suite.Equal(context.Background(), suite.client.Context(), "original client must be unchanged")
+
+	_, err := client.StoreProfileGet()
+	suite.Nil(err)
+	suite.Equal("value", actual, "request context")
+
+	_, err = suite.client.StoreProfileGet()
+	suite.Nil(err)
+	suite.Nil(actual, "original client context")
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func (suite *EcwidTestSuite) TestNewOptions() {
+	const (
+		userAgent = "go-ecwid-test"
+	)
+
+	expectedEndpoint := fmt.Sprintf("http://localhost:8080/api/v3/%d/?token=%s", storeID, token)
+	requested := false
+
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requested = true
+
+		suite.Equal(expectedEndpoint, req.URL.String(), "endpoint")
+		suite.Equal(userAgent, req.Header.Get("User-Agent"), "User-Agent")
+
+		return httpmock.NewStringResponse(200, ""), nil
+	})
+
+	original := &http.Transport{}
+	httpClient := &http.Client{Transport: original, Timeout: time.Minute}
+	client := New(storeID, token,
+		WithHTTPClient(httpClient),
+		WithBaseURL("http://localhost:8080/api/v3/"),
+		WithTransport(transport),
+		WithTimeout(time.Second),
+		WithUserAgent(userAgent),
+	)
+
+	suite.True(httpClient != client.GetClient(), "http client is copied")
+	suite.Equal(time.Second, client.GetClient().Timeout, "timeout")
+	suite.Equal(time.Minute, httpClient.Timeout, "caller's timeout is unchanged")
+	suite.True(httpClient.Transport == original, "caller's transport is unchanged")
+
+	_, err := client.R().Get("/")
+	suite.Nil(err)
+	suite.Truef(requested, "request failed")
+}
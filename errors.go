@@ -0,0 +1,49 @@
+package ecwid
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors for use with errors.Is
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrNotUpdated   = errors.New("no updated")
+	ErrNotDeleted   = errors.New("no deleted")
+)
+
+type (
+	// APIError is non 200 response of Ecwid API
+	APIError struct {
+		StatusCode   int    // HTTP status code
+		ErrorMessage string // Ecwid errorMessage, may be empty
+		ErrorCode    string // Ecwid errorCode, may be empty
+		Method       string // request method
+		Path         string // request path without query
+	}
+)
+
+// Error returns Ecwid errorMessage if present or HTTP status text else
+func (e *APIError) Error() string {
+	message := e.ErrorMessage
+	if len(message) == 0 {
+		message = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("ecwid: %s %s: %d %s", e.Method, e.Path, e.StatusCode, message)
+}
+
+// Is matches ErrNotFound, ErrUnauthorized and ErrRateLimited by status code
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package ecwid
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ErrorsTestSuite struct {
+	ClientTestSuite
+}
+
+func TestErrorsTestSuite(t *testing.T) {
+	suite.Run(t, new(ErrorsTestSuite))
+}
+
+func (suite *ErrorsTestSuite) TestAPIError() {
+	const (
+		productID ID = 999
+	)
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(404, `{"errorMessage":"Product not found","errorCode":"NOT_FOUND"}`), nil
+		})
+
+	_, err := suite.client.ProductGet(productID)
+	suite.NotNil(err)
+	suite.True(errors.Is(err, ErrNotFound), "ErrNotFound")
+	suite.False(errors.Is(err, ErrUnauthorized), "ErrUnauthorized")
+
+	var apiErr *APIError
+	suite.True(errors.As(err, &apiErr))
+	suite.Equal(404, apiErr.StatusCode, "status code")
+	suite.Equal("Product not found", apiErr.ErrorMessage, "error message")
+	suite.Equal("NOT_FOUND", apiErr.ErrorCode, "error code")
+	suite.Equal("GET", apiErr.Method, "method")
+	suite.Equal(fmt.Sprintf("/api/v3/%d/products/%d", storeID, productID), apiErr.Path, "path")
+}
+
+func (suite *ErrorsTestSuite) TestAPIErrorStatus() {
+	for status, sentinel := range map[int]error{
+		401: ErrUnauthorized,
+		403: ErrUnauthorized,
+		429: ErrRateLimited,
+	} {
+		httpmock.RegisterNoResponder(httpmock.NewStringResponder(status, ""))
+
+		_, err := suite.client.StoreProfileGet()
+		suite.True(errors.Is(err, sentinel), "status %d", status)
+		suite.Contains(err.Error(), http.StatusText(status))
+	}
+}
+
+func (suite *ErrorsTestSuite) TestNotUpdatedDeleted() {
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(200, `{"updateCount":0,"deleteCount":0}`))
+
+	err := suite.client.ProductUpdate(1, &NewProduct{})
+	suite.True(errors.Is(err, ErrNotUpdated), "ErrNotUpdated")
+
+	err = suite.client.ProductDelete(1)
+	suite.True(errors.Is(err, ErrNotDeleted), "ErrNotDeleted")
+}
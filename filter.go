@@ -0,0 +1,110 @@
+package ecwid
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidFilter is wrapped by filter validation errors
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// setTimeRange sets unix timestamps of non zero bounds
+func setTimeRange(filter map[string]string, fromKey, toKey string, from, to time.Time) {
+	if !from.IsZero() {
+		filter[fromKey] = strconv.FormatInt(from.Unix(), 10)
+	}
+	if !to.IsZero() {
+		filter[toKey] = strconv.FormatInt(to.Unix(), 10)
+	}
+}
+
+func joinIDs(ids []ID) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = fmt.Sprintf("%d", id)
+	}
+	return strings.Join(s, ",")
+}
+
+// filterTimeLayouts are date formats accepted by Ecwid search filters besides unix timestamp
+var filterTimeLayouts = []string{
+	dateTimeLayout,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC3339,
+}
+
+// parseFilterTime parses unix timestamp or date in one of filterTimeLayouts
+func parseFilterTime(value string) (t time.Time, err error) {
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(unix, 0), nil
+	}
+	for _, layout := range filterTimeLayouts {
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return t, err
+}
+
+// validateTimeRange checks both bounds are dates and from is not after to
+func validateTimeRange(filter map[string]string, fromKey, toKey string) error {
+	var from, to time.Time
+	for _, bound := range []struct {
+		key  string
+		time *time.Time
+	}{{fromKey, &from}, {toKey, &to}} {
+		value, ok := filter[bound.key]
+		if !ok {
+			continue
+		}
+		t, err := parseFilterTime(value)
+		if err != nil {
+			return fmt.Errorf("%w: %s %q is not a date", ErrInvalidFilter, bound.key, value)
+		}
+		*bound.time = t
+	}
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return fmt.Errorf("%w: %s is after %s", ErrInvalidFilter, fromKey, toKey)
+	}
+	return nil
+}
+
+// validateNumberRange checks both bounds are non negative numbers and from is not greater than to
+func validateNumberRange(filter map[string]string, fromKey, toKey string) error {
+	bounds := make(map[string]float64)
+	for _, key := range []string{fromKey, toKey} {
+		value, ok := filter[key]
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || n < 0 {
+			return fmt.Errorf("%w: %s %q is not a non negative number", ErrInvalidFilter, key, value)
+		}
+		bounds[key] = n
+	}
+	from, okFrom := bounds[fromKey]
+	to, okTo := bounds[toKey]
+	if okFrom && okTo && from > to {
+		return fmt.Errorf("%w: %s is greater than %s", ErrInvalidFilter, fromKey, toKey)
+	}
+	return nil
+}
+
+// validateIDs checks value is comma separated IDs
+func validateIDs(filter map[string]string, key string) error {
+	value, ok := filter[key]
+	if !ok {
+		return nil
+	}
+	for _, id := range strings.Split(value, ",") {
+		if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+			return fmt.Errorf("%w: %s %q is not an ID", ErrInvalidFilter, key, value)
+		}
+	}
+	return nil
+}
@@ -1,9 +1,16 @@
 module github.com/sevkin/go-ecwid
 
-go 1.13
+go 1.24
 
 require (
 	github.com/go-resty/resty/v2 v2.0.0
 	github.com/jarcoal/httpmock v1.0.4
 	github.com/stretchr/testify v1.4.0
 )
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.0.0-20190628185345-da137c7871d7 // indirect
+	gopkg.in/yaml.v2 v2.2.2 // indirect
+)
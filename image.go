@@ -0,0 +1,82 @@
+package ecwid
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotImage is returned by image uploads when data is not an image
+var ErrNotImage = errors.New("ecwid: not an image")
+
+type (
+	// ImageOption configures image uploads
+	ImageOption func(*imageOptions)
+
+	imageOptions struct {
+		contentType string
+		filename    string
+	}
+)
+
+// WithContentType overrides detected Content-Type of uploaded image,
+// it must be image/* type. Data recognized as non-image is still rejected
+func WithContentType(contentType string) ImageOption {
+	return func(o *imageOptions) {
+		o.contentType = contentType
+	}
+}
+
+// withFilename hints image type by file extension when content is not recognized
+func withFilename(filename string) ImageOption {
+	return func(o *imageOptions) {
+		o.filename = filename
+	}
+}
+
+// detectImage returns reader with the same data as image and its Content-Type.
+// Content-Type is sniffed from the data. Data unknown to the sniffer
+// (like AVIF or HEIC) is accepted by WithContentType or image file extension.
+// ErrNotImage is returned for data recognized as non-image
+func detectImage(image io.Reader, opts []ImageOption) (io.Reader, string, error) {
+	var o imageOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if len(o.contentType) > 0 && !isImageType(o.contentType) {
+		return nil, "", ErrNotImage
+	}
+
+	reader := bufio.NewReaderSize(image, 512)
+	head, err := reader.Peek(512)
+	if err != nil && err != io.EOF {
+		return nil, "", err
+	}
+
+	contentType := http.DetectContentType(head)
+	if contentType == "application/octet-stream" {
+		if len(o.contentType) > 0 {
+			contentType = o.contentType
+		} else if len(o.filename) > 0 {
+			contentType = mime.TypeByExtension(strings.ToLower(filepath.Ext(o.filename)))
+		}
+	}
+	if !isImageType(contentType) {
+		return nil, "", ErrNotImage
+	}
+
+	if len(o.contentType) > 0 {
+		contentType = o.contentType
+	}
+	return reader, contentType, nil
+}
+
+func isImageType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && strings.HasPrefix(mediaType, "image/")
+}
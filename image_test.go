@@ -0,0 +1,99 @@
+package ecwid
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ImageTestSuite struct {
+	suite.Suite
+}
+
+func TestImageTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageTestSuite))
+}
+
+func (suite *ImageTestSuite) TestDetectImage() {
+	for data, expected := range map[string]string{
+		"\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR": "image/png",
+		"GIF89a\x01\x00\x01\x00":              "image/gif",
+		"RIFF\x00\x00\x00\x00WEBPVP8 ":        "image/webp",
+		"\xff\xd8\xff\xe0\x00\x10JFIF":        "image/jpeg",
+	} {
+		reader, contentType, err := detectImage(strings.NewReader(data), nil)
+		suite.Nil(err)
+		suite.Equal(expected, contentType)
+
+		body, err := ioutil.ReadAll(reader)
+		suite.Nil(err)
+		suite.Equal(data, string(body), "data is not lost by sniffing")
+	}
+}
+
+func (suite *ImageTestSuite) TestDetectImageNotImage() {
+	_, _, err := detectImage(strings.NewReader("<html><body>hello</body></html>"), nil)
+	suite.Equal(ErrNotImage, err)
+
+	_, _, err = detectImage(strings.NewReader(""), nil)
+	suite.Equal(ErrNotImage, err)
+
+	_, _, err = detectImage(strings.NewReader("\x00\x01\x02"), []ImageOption{withFilename("data.bin")})
+	suite.Equal(ErrNotImage, err)
+}
+
+func (suite *ImageTestSuite) TestDetectImageByExtension() {
+	_, contentType, err := detectImage(strings.NewReader("\x00\x01\x02"), []ImageOption{withFilename("photo.PNG")})
+	suite.Nil(err)
+	suite.Equal("image/png", contentType)
+
+	_, _, err = detectImage(strings.NewReader("just text"), []ImageOption{withFilename("photo.png")})
+	suite.Equal(ErrNotImage, err, "recognized non-image data is rejected")
+}
+
+func (suite *ImageTestSuite) TestDetectImageContentType() {
+	reader, contentType, err := detectImage(strings.NewReader("\x89PNG\r\n\x1a\n"), []ImageOption{WithContentType("image/x-png")})
+	suite.Nil(err)
+	suite.Equal("image/x-png", contentType)
+
+	body, err := ioutil.ReadAll(reader)
+	suite.Nil(err)
+	suite.Equal("\x89PNG\r\n\x1a\n", string(body), "data is not lost by sniffing")
+
+	_, contentType, err = detectImage(strings.NewReader("\x00\x01\x02"), []ImageOption{withFilename("photo.avif"), WithContentType("image/avif")})
+	suite.Nil(err)
+	suite.Equal("image/avif", contentType, "unrecognized data with image extension")
+
+	_, _, err = detectImage(strings.NewReader("\x89PNG\r\n\x1a\n"), []ImageOption{WithContentType("text/plain")})
+	suite.Equal(ErrNotImage, err)
+}
+
+func (suite *ImageTestSuite) TestDetectImageContentTypeUnknownFormat() {
+	for data, contentType := range map[string]string{
+		"\x00\x00\x00\x1cftypavif\x00\x00\x00\x00avifmif1miaf": "image/avif",
+		"\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic":     "image/heic",
+	} {
+		_, _, err := detectImage(strings.NewReader(data), nil)
+		suite.Equal(ErrNotImage, err, "unknown format without override")
+
+		reader, actual, err := detectImage(strings.NewReader(data), []ImageOption{WithContentType(contentType)})
+		suite.Nil(err, contentType)
+		suite.Equal(contentType, actual)
+
+		body, err := ioutil.ReadAll(reader)
+		suite.Nil(err)
+		suite.Equal(data, string(body), "data is not lost by sniffing")
+	}
+}
+
+func (suite *ImageTestSuite) TestDetectImageContentTypeNotImage() {
+	for data, name := range map[string]string{
+		"<html><body>hello</body></html>":    "html",
+		"PK\x03\x04\x14\x00\x00\x00\x08\x00": "zip",
+	} {
+		_, _, err := detectImage(strings.NewReader(data), []ImageOption{WithContentType("image/png")})
+		suite.Equal(ErrNotImage, err, name)
+	}
+}
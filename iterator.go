@@ -0,0 +1,122 @@
+package ecwid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+type (
+	// pageFetcher fetches one page of search results by filter
+	pageFetcher[T any] func(ctx context.Context, filter map[string]string) ([]T, *SearchResponse, error)
+
+	// Iterator over paginated search results.
+	// Iterator is not safe for concurrent use
+	//
+	//	it := client.ProductsIterator(filter)
+	//	for it.Next(ctx) {
+	//		product := it.Item()
+	//	}
+	//	if err := it.Err(); err != nil {
+	//	}
+	Iterator[T any] struct {
+		filter map[string]string
+		fetch  pageFetcher[T]
+		start  uint // offset of the first item
+		count  uint // returned items
+		items  []T
+		pos    int
+		item   T
+		done   bool
+		err    error
+	}
+)
+
+func newIterator[T any](filter map[string]string, fetch pageFetcher[T]) *Iterator[T] {
+	return &Iterator[T]{
+		filter: copyFilter(filter),
+		fetch:  fetch,
+		start:  filterOffset(filter),
+	}
+}
+
+func copyFilter(filter map[string]string) map[string]string {
+	filterCopy := make(map[string]string, len(filter))
+	for k, v := range filter {
+		filterCopy[k] = v
+	}
+	return filterCopy
+}
+
+// Next advances to the next item, fetching the next page if needed.
+// Returns false when iteration is finished or failed, see Err
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	for it.pos >= len(it.items) {
+		if it.done {
+			return false
+		}
+
+		items, resp, err := it.fetch(ctx, it.filter)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.items = items
+		it.pos = 0
+
+		next := resp.Offset + resp.Count
+		if resp.Count == 0 || next >= resp.Total {
+			it.done = true
+		} else {
+			it.filter["offset"] = fmt.Sprintf("%d", next)
+		}
+	}
+
+	it.item = it.items[it.pos]
+	it.pos++
+	it.count++
+	return true
+}
+
+// Item returns the current item
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error which stopped iteration, nil if iteration is finished
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Cursor returns position after the current item.
+// Iteration resumed from Cursor starts from the next item,
+// or from the failed page if Next returned false with error
+func (it *Iterator[T]) Cursor() Cursor {
+	return newCursor(it.filter, it.start+it.count)
+}
+
+// Seq adapts Iterator to range-over-func.
+// Error if any is yielded last with zero item
+func (it *Iterator[T]) Seq(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next(ctx) {
+			if !yield(it.Item(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
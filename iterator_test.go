@@ -0,0 +1,132 @@
+package ecwid
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type IteratorTestSuite struct {
+	ClientTestSuite
+}
+
+func TestIteratorTestSuite(t *testing.T) {
+	suite.Run(t, new(IteratorTestSuite))
+}
+
+// pages returns fetcher of total items by limit with error on failAt offset
+func pages(total, limit, failAt int) pageFetcher[int] {
+	return func(ctx context.Context, filter map[string]string) ([]int, *SearchResponse, error) {
+		offset, _ := strconv.Atoi(filter["offset"])
+		if offset == failAt {
+			return nil, nil, errors.New("fail")
+		}
+
+		items := make([]int, 0, limit)
+		for i := offset; i < offset+limit && i < total; i++ {
+			items = append(items, i)
+		}
+		return items, &SearchResponse{
+			Total:  uint(total),
+			Count:  uint(len(items)),
+			Offset: uint(offset),
+			Limit:  uint(limit),
+		}, nil
+	}
+}
+
+func (suite *IteratorTestSuite) TestIterator() {
+	filter := map[string]string{"limit": "2"}
+	it := newIterator(filter, pages(5, 2, -1))
+
+	actual := make([]int, 0, 5)
+	for it.Next(context.Background()) {
+		actual = append(actual, it.Item())
+	}
+	suite.Nil(it.Err())
+	suite.Equal([]int{0, 1, 2, 3, 4}, actual)
+	suite.False(it.Next(context.Background()), "finished iterator")
+	suite.Empty(filter["offset"], "filter map must be unchanged")
+}
+
+func (suite *IteratorTestSuite) TestIteratorError() {
+	it := newIterator(nil, pages(5, 2, 2))
+
+	actual := make([]int, 0, 5)
+	for it.Next(context.Background()) {
+		actual = append(actual, it.Item())
+	}
+	suite.EqualError(it.Err(), "fail")
+	suite.Equal([]int{0, 1}, actual)
+}
+
+func (suite *IteratorTestSuite) TestIteratorCancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := newIterator(nil, pages(5, 5, -1))
+
+	suite.True(it.Next(ctx))
+	cancel()
+	suite.False(it.Next(ctx))
+	suite.Equal(context.Canceled, it.Err())
+}
+
+func (suite *IteratorTestSuite) TestSeq() {
+	actual := make([]int, 0, 5)
+	var err error
+	for item, e := range newIterator(nil, pages(5, 2, 4)).Seq(context.Background()) {
+		if e != nil {
+			err = e
+			break
+		}
+		actual = append(actual, item)
+	}
+	suite.EqualError(err, "fail")
+	suite.Equal([]int{0, 1, 2, 3}, actual)
+
+	actual = actual[:0]
+	for item := range newIterator(nil, pages(5, 2, -1)).Seq(context.Background()) {
+		if item == 2 {
+			break
+		}
+		actual = append(actual, item)
+	}
+	suite.Equal([]int{0, 1}, actual)
+}
+
+func (suite *IteratorTestSuite) TestOrdersSeq() {
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("offset") == "" {
+				return httpmock.NewJsonResponse(200, OrdersSearchResponse{
+					SearchResponse: SearchResponse{
+						Total: 2,
+						Count: 1,
+					},
+					Items: []*Order{
+						&Order{OrderID: 1},
+					},
+				})
+			}
+			return httpmock.NewStringResponse(500, ""), nil
+		})
+
+	ids := make([]ID, 0, 2)
+	var err error
+	for order, e := range suite.client.OrdersSeq(context.Background(), nil) {
+		if e != nil {
+			err = e
+			break
+		}
+		ids = append(ids, order.OrderID)
+	}
+	suite.Equal([]ID{1}, ids)
+
+	var apiErr *APIError
+	suite.True(errors.As(err, &apiErr), "error must be reported")
+	suite.Equal(500, apiErr.StatusCode)
+}
@@ -0,0 +1,173 @@
+package ecwid
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Money keeps moneyDigits fractional digits as int64 scaled by moneyScale
+const (
+	moneyDigits = 6
+	moneyScale  = 1000000
+)
+
+type (
+	// Money is exact decimal amount with 6 fractional digits.
+	// Zero Money is 0, marshaled as json number
+	Money struct {
+		value int64 // amount * moneyScale
+	}
+)
+
+// NewMoney converts float to Money rounding to 6 fractional digits
+func NewMoney(amount float64) Money {
+	return Money{int64(math.Round(amount * moneyScale))}
+}
+
+// ParseMoney parses decimal number like "12.99" or "1.5e-3".
+// Digits beyond 6 fractional are rounded half away from zero
+func ParseMoney(s string) (Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return Money{}, fmt.Errorf("ecwid: invalid money %q", s)
+	}
+
+	r.Mul(r, new(big.Rat).SetInt64(moneyScale))
+	value := roundRat(r)
+	if !value.IsInt64() {
+		return Money{}, fmt.Errorf("ecwid: money %q out of range", s)
+	}
+	return Money{value.Int64()}, nil
+}
+
+// MustParseMoney is like ParseMoney but panics on error
+func MustParseMoney(s string) Money {
+	m, err := ParseMoney(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// roundRat rounds half away from zero
+func roundRat(r *big.Rat) *big.Int {
+	num := new(big.Int).Abs(r.Num())
+	quo, rem := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(r.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	if r.Sign() < 0 {
+		quo.Neg(quo)
+	}
+	return quo
+}
+
+// Add returns m + o
+func (m Money) Add(o Money) Money {
+	return Money{m.value + o.value}
+}
+
+// Sub returns m - o
+func (m Money) Sub(o Money) Money {
+	return Money{m.value - o.value}
+}
+
+// Mul returns m multiplied by quantity
+func (m Money) Mul(quantity int64) Money {
+	return Money{m.value * quantity}
+}
+
+// Percent returns percent of m, e.g. tax 8.875% of price
+func (m Money) Percent(percent float64) Money {
+	p := NewMoney(percent)
+	r := new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(m.value), big.NewInt(p.value)),
+		big.NewInt(100*moneyScale),
+	)
+	return Money{roundRat(r).Int64()}
+}
+
+// Round rounds m half away from zero to precision fractional digits,
+// e.g. to currency precision of the store
+func (m Money) Round(precision int) Money {
+	if precision >= moneyDigits {
+		return m
+	}
+	if precision < 0 {
+		precision = 0
+	}
+	unit := int64(math.Pow10(moneyDigits - precision))
+	r := new(big.Rat).SetFrac(big.NewInt(m.value), big.NewInt(unit))
+	return Money{roundRat(r).Int64() * unit}
+}
+
+// Cmp compares m and o and returns -1, 0 or +1
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m.value < o.value:
+		return -1
+	case m.value > o.value:
+		return 1
+	}
+	return 0
+}
+
+// IsZero reports whether m is zero
+func (m Money) IsZero() bool {
+	return m.value == 0
+}
+
+// Float64 returns m as float, may lose precision
+func (m Money) Float64() float64 {
+	return float64(m.value) / moneyScale
+}
+
+// String formats m as decimal without trailing zeros, e.g. "12.99"
+func (m Money) String() string {
+	value := m.value
+	sign := ""
+	if value < 0 {
+		sign = "-"
+	}
+
+	abs := new(big.Int).Abs(big.NewInt(value))
+	quo, rem := new(big.Int).QuoRem(abs, big.NewInt(moneyScale), new(big.Int))
+	if rem.Sign() == 0 {
+		return sign + quo.String()
+	}
+
+	frac := fmt.Sprintf("%0*d", moneyDigits, rem.Int64())
+	return sign + quo.String() + "." + strings.TrimRight(frac, "0")
+}
+
+// MarshalJSON marshal as json number
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON unmarshal json number, numeric string or null
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*m = Money{}
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		s, err := strconv.Unquote(string(data))
+		if err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+
+	money, err := ParseMoney(string(data))
+	if err != nil {
+		return err
+	}
+	*m = money
+	return nil
+}
@@ -0,0 +1,69 @@
+package ecwid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MoneyTestSuite struct {
+	suite.Suite
+}
+
+func TestMoneyTestSuite(t *testing.T) {
+	suite.Run(t, new(MoneyTestSuite))
+}
+
+func (suite *MoneyTestSuite) TestParseMoney() {
+	for s, expected := range map[string]string{
+		"12.99":     "12.99",
+		"0":         "0",
+		"-1.50":     "-1.5",
+		"1e-3":      "0.001",
+		"0.1234565": "0.123457",
+		"100":       "100",
+	} {
+		m, err := ParseMoney(s)
+		suite.Nil(err, s)
+		suite.Equal(expected, m.String(), s)
+	}
+
+	_, err := ParseMoney("ten")
+	suite.NotNil(err)
+}
+
+func (suite *MoneyTestSuite) TestArithmetic() {
+	price := MustParseMoney("0.1")
+	suite.Equal("0.3", price.Add(price).Add(price).String(), "no float error")
+	suite.Equal("0.2", price.Mul(3).Sub(price).String())
+	suite.Equal("29.97", MustParseMoney("9.99").Mul(3).String())
+
+	suite.Equal("0.887", MustParseMoney("9.99").Percent(8.875).Round(3).String())
+	suite.Equal("0.89", MustParseMoney("9.99").Percent(8.875).Round(2).String())
+	suite.Equal("-1.13", MustParseMoney("-1.125").Round(2).String(), "half away from zero")
+	suite.Equal("13", MustParseMoney("12.5").Round(0).String())
+
+	suite.Equal(-1, MustParseMoney("1").Cmp(MustParseMoney("1.01")))
+	suite.Equal(0, NewMoney(1.01).Cmp(MustParseMoney("1.01")))
+	suite.True(Money{}.IsZero())
+	suite.Equal(12.99, MustParseMoney("12.99").Float64())
+}
+
+func (suite *MoneyTestSuite) TestMoneyJSON() {
+	var item OrderItem
+
+	err := json.Unmarshal([]byte(`{"price":19.99,"tax":"1.6","shipping":null}`), &item)
+	suite.Nil(err)
+	suite.Equal(MustParseMoney("19.99"), item.Price)
+	suite.Equal(MustParseMoney("1.6"), item.Tax)
+	suite.True(item.Shipping.IsZero())
+
+	data, err := json.Marshal(&WholesalePrice{Quantity: 10, Price: MustParseMoney("1234567.89")})
+	suite.Nil(err)
+	suite.Equal(`{"quantity":10,"price":1234567.89}`, string(data))
+
+	data, err = json.Marshal(&WholesalePrice{Quantity: 10})
+	suite.Nil(err)
+	suite.Equal(`{"quantity":10}`, string(data), "omit zero")
+}
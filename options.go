@@ -0,0 +1,60 @@
+package ecwid
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+type (
+	// Option configures Client in New
+	Option func(*options)
+
+	options struct {
+		baseURL    string
+		httpClient *http.Client
+		transport  http.RoundTripper
+		timeout    time.Duration
+		userAgent  string
+		retry      *RetryPolicy
+		limiter    *RateLimiter
+	}
+)
+
+// WithBaseURL sets API base URL without store ID,
+// default is https://app.ecwid.com/api/v3
+func WithBaseURL(baseURL string) Option {
+	return func(o *options) {
+		o.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient sets http.Client used by resty.Client.
+// The client is copied, other options do not modify it
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTransport sets http.RoundTripper of http.Client,
+// for example to route requests through a proxy
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = transport
+	}
+}
+
+// WithTimeout sets timeout of each request
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithUserAgent sets User-Agent header of each request
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
@@ -4,35 +4,37 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
+	"os"
 )
 
 type (
 	// NewOrder https://developers.ecwid.com/api-documentation/orders#create-order
 	NewOrder struct {
-		Subtotal                        float32             `json:"subtotal,omitempty"`
-		Total                           float32             `json:"total,omitempty"`
+		Subtotal                        Money               `json:"subtotal,omitzero"`
+		Total                           Money               `json:"total,omitzero"`
 		Email                           string              `json:"email,omitempty"`
 		PaymentMethod                   string              `json:"paymentMethod,omitempty"`
 		PaymentModule                   string              `json:"paymentModule,omitempty"`
-		Tax                             float32             `json:"tax,omitempty"`
+		Tax                             Money               `json:"tax,omitzero"`
 		CustomerTaxExempt               bool                `json:"customerTaxExempt,omitempty"`
 		CustomerTaxID                   string              `json:"customerTaxId,omitempty"`
 		CustomerTaxIDValid              bool                `json:"customerTaxIdValid,omitempty"`
 		ReversedTaxApplied              bool                `json:"reversedTaxApplied,omitempty"`
 		IPAddress                       string              `json:"ipAddress,omitempty"`
-		CouponDiscount                  float32             `json:"couponDiscount,omitempty"`
+		CouponDiscount                  Money               `json:"couponDiscount,omitzero"`
 		PaymentStatus                   PaymentStatus       `json:"paymentStatus,omitempty"`
 		FulfillmentStatus               FulfillmentStatus   `json:"fulfillmentStatus,omitempty"`
 		RefererURL                      string              `json:"refererUrl,omitempty"`
 		OrderComments                   string              `json:"orderComments,omitempty"`
-		VolumeDiscount                  float32             `json:"volumeDiscount,omitempty"`
+		VolumeDiscount                  Money               `json:"volumeDiscount,omitzero"`
 		CustomerID                      ID                  `json:"customerId,omitempty"`
 		Hidden                          bool                `json:"hidden,omitempty"`
-		MembershipBasedDiscount         float32             `json:"membershipBasedDiscount,omitempty"`
-		TotalAndMembershipBasedDiscount float32             `json:"totalAndMembershipBasedDiscount,omitempty"`
-		Discount                        float32             `json:"discount,omitempty"`
+		MembershipBasedDiscount         Money               `json:"membershipBasedDiscount,omitzero"`
+		TotalAndMembershipBasedDiscount Money               `json:"totalAndMembershipBasedDiscount,omitzero"`
+		Discount                        Money               `json:"discount,omitzero"`
 		GlobalReferer                   string              `json:"globalReferer,omitempty"`
-		CreateDate                      DateTime            `json:"createDate,omitempty"`
+		CreateDate                      DateTime            `json:"createDate,omitzero"`
 		CustomerGroup                   string              `json:"customerGroup,omitempty"`
 		DiscountCoupon                  *DiscountCouponInfo `json:"discountCoupon,omitempty"`
 		Items                           []*OrderItem        `json:"items,omitempty"`
@@ -49,7 +51,7 @@ type (
 		AffiliateID                     string              `json:"affiliateId,omitempty"`
 		CreditCardStatus                *CreditCardStatus   `json:"creditCardStatus,omitempty"`
 		PrivateAdminNotes               string              `json:"privateAdminNotes,omitempty"`
-		PickupTime                      DateTime            `json:"pickupTime,omitempty"`
+		PickupTime                      DateTime            `json:"pickupTime,omitzero"`
 		AcceptMarketing                 bool                `json:"acceptMarketing,omitempty"`
 		DisableAllCustomerNotifications bool                `json:"disableAllCustomerNotifications,omitempty"`
 		ExternalFulfillment             bool                `json:"externalFulfillment,omitempty"`
@@ -61,14 +63,14 @@ type (
 		NewOrder
 		OrderID           ID               `json:"orderNumber"`
 		VendorOrderNumber string           `json:"vendorOrderNumber"`
-		USDTotal          float32          `json:"usdTotal"`
+		USDTotal          Money            `json:"usdTotal"`
 		UpdateDate        DateTime         `json:"updateDate"`
-		CreateTimestamp   uint64           `json:"createTimestamp"`
-		UpdateTimestamp   uint64           `json:"updateTimestamp"`
+		CreateTimestamp   Timestamp        `json:"createTimestamp"`
+		UpdateTimestamp   Timestamp        `json:"updateTimestamp"`
 		CustomerGroupID   ID               `json:"customerGroupId"`
 		PredictedPackages PredictedPackage `json:"predictedPackages"`
 		ExtraFields       ExtraFieldsInfo  `json:"extraFields,omitempty"`
-		RefundedAmount    float32          `json:"refundedAmount"`
+		RefundedAmount    Money            `json:"refundedAmount"`
 		Refunds           []RefundsInfo    `json:"refunds"`
 		RefererID         string           `json:"refererId"`
 		TaxesOnShipping   []TaxOnShipping  `json:"taxesOnShipping,omitempty"` // only in Get ???
@@ -82,22 +84,23 @@ type (
 
 	// OrderItem contains order items
 	OrderItem struct {
+		ID                    ID                `json:"id,omitempty"`
 		Name                  string            `json:"name"`
 		Quantity              uint              `json:"quantity"`
 		ProductID             ID                `json:"productId"`
 		CategoryID            ID                `json:"categoryId"`
-		Price                 float32           `json:"price"`
-		ProductPrice          float32           `json:"productPrice"`
+		Price                 Money             `json:"price"`
+		ProductPrice          Money             `json:"productPrice"`
 		Weight                float32           `json:"weight"`
 		Sku                   string            `json:"sku"`
 		ShortDescription      string            `json:"shortDescription"`
-		Tax                   float32           `json:"tax"`
-		Shipping              float32           `json:"shipping"`
+		Tax                   Money             `json:"tax"`
+		Shipping              Money             `json:"shipping"`
 		QuantityInStock       uint              `json:"quantityInStock"`
 		IsShippingRequired    bool              `json:"isShippingRequired"`
 		TrackQuantity         bool              `json:"trackQuantity"`
 		FixedShippingRateOnly bool              `json:"fixedShippingRateOnly"`
-		FixedShippingRate     float32           `json:"fixedShippingRate"`
+		FixedShippingRate     Money             `json:"fixedShippingRate"`
 		Digital               bool              `json:"digital"`
 		CouponApplied         bool              `json:"couponApplied"`
 		SelectedOptions       []OrderItemOption `json:"selectedOptions"`
@@ -112,7 +115,12 @@ type (
 // couponCode orderId vendorOrderId
 // email customerId paymentMethod shippingMethod paymentStatus fulfillmentStatus
 // acceptMarketing refererId productId offset limit
+// see OrderFilter, filter is validated before request
 func (c *Client) OrdersSearch(filter map[string]string) (*OrdersSearchResponse, error) {
+	if err := OrderFilter(filter).Validate(); err != nil {
+		return nil, err
+	}
+
 	response, err := c.R().
 		SetQueryParams(filter).
 		Get("/orders")
@@ -122,13 +130,15 @@ func (c *Client) OrdersSearch(filter map[string]string) (*OrdersSearchResponse,
 }
 
 // Orders 'iterable' by filtered store orders
+//
+// Deprecated: errors are silently dropped, use OrdersIterator or OrdersSeq instead
 func (c *Client) Orders(ctx context.Context, filter map[string]string) <-chan *Order {
 	orderChan := make(chan *Order)
 
 	go func() {
 		defer close(orderChan)
 
-		c.OrdersTrampoline(filter, func(index uint, order *Order) error {
+		c.WithContext(ctx).OrdersTrampoline(filter, func(index uint, order *Order) error {
 			// FIXME silent error. maybe orderChan <- nil ?
 			select {
 			case <-ctx.Done():
@@ -142,6 +152,24 @@ func (c *Client) Orders(ctx context.Context, filter map[string]string) <-chan *O
 	return orderChan
 }
 
+// OrdersIterator iterates by filtered store orders
+func (c *Client) OrdersIterator(filter map[string]string) *Iterator[*Order] {
+	return newIterator(filter, c.ordersPage)
+}
+
+// OrdersSeq iterates by filtered store orders with range-over-func
+func (c *Client) OrdersSeq(ctx context.Context, filter map[string]string) iter.Seq2[*Order, error] {
+	return c.OrdersIterator(filter).Seq(ctx)
+}
+
+func (c *Client) ordersPage(ctx context.Context, filter map[string]string) ([]*Order, *SearchResponse, error) {
+	resp, err := c.WithContext(ctx).OrdersSearch(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Items, &resp.SearchResponse, nil
+}
+
 // OrderGet gets all details of a specific order in an Ecwid store by its ID
 func (c *Client) OrderGet(orderID ID) (*Order, error) {
 	response, err := c.R().
@@ -161,11 +189,40 @@ func (c *Client) OrderUpdate(orderID ID, order *NewOrder) error {
 	return responseUpdate(response, err)
 }
 
-// TODO add more order methods
-// Get order invoice
-// Update order
-// Delete order
-// Create order
-// Upload item option file
-// Delete item option file
-// Delete all item option’s files
+// OrderAdd creates a new order in an Ecwid store
+// returns new orderNumber
+func (c *Client) OrderAdd(order *NewOrder) (ID, error) {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(order).
+		Post("/orders")
+
+	return responseAdd(response, err)
+}
+
+// OrderDelete delete an order from an Ecwid store referring to its ID
+func (c *Client) OrderDelete(orderID ID) error {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/orders/%d", orderID))
+
+	_, err = responseDelete(response, err)
+	return err
+}
+
+// OrderInvoiceGet gets order invoice as HTML page
+func (c *Client) OrderInvoiceGet(orderID ID) ([]byte, error) {
+	response, err := c.R().
+		Get(fmt.Sprintf("/orders/%d/invoice", orderID))
+
+	return responseBody(response, err)
+}
+
+// OrderInvoiceGetFile saves order invoice HTML page to local file
+func (c *Client) OrderInvoiceGetFile(orderID ID, filename string) error {
+	invoice, err := c.OrderInvoiceGet(orderID)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, invoice, 0644)
+}
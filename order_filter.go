@@ -0,0 +1,165 @@
+package ecwid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OrderFilter builds filter of OrdersSearch, Orders, OrdersTrampoline, etc.
+// It is a map[string]string, so it can be passed anywhere a filter is expected:
+//
+//	client.OrdersSearch(ecwid.NewOrderFilter().PaymentStatus(ecwid.PaymentPaid, ecwid.PaymentRefunded))
+//
+// OrdersSearch validates filter before request, see Validate
+type OrderFilter map[string]string
+
+// NewOrderFilter creates empty OrderFilter
+func NewOrderFilter() OrderFilter {
+	return make(OrderFilter)
+}
+
+// Keywords searches term in order number, customer name, email, etc.
+func (f OrderFilter) Keywords(keywords string) OrderFilter {
+	f["keywords"] = keywords
+	return f
+}
+
+// TotalFrom sets minimum order total
+func (f OrderFilter) TotalFrom(total Money) OrderFilter {
+	f["totalFrom"] = total.String()
+	return f
+}
+
+// TotalTo sets maximum order total
+func (f OrderFilter) TotalTo(total Money) OrderFilter {
+	f["totalTo"] = total.String()
+	return f
+}
+
+// Created sets order placement date range, zero bound is omitted
+func (f OrderFilter) Created(from, to time.Time) OrderFilter {
+	setTimeRange(f, "createdFrom", "createdTo", from, to)
+	return f
+}
+
+// Updated sets order last update date range, zero bound is omitted
+func (f OrderFilter) Updated(from, to time.Time) OrderFilter {
+	setTimeRange(f, "updatedFrom", "updatedTo", from, to)
+	return f
+}
+
+// CouponCode filters orders with applied discount coupon
+func (f OrderFilter) CouponCode(couponCode string) OrderFilter {
+	f["couponCode"] = couponCode
+	return f
+}
+
+// OrderID sets order number
+func (f OrderFilter) OrderID(orderID ID) OrderFilter {
+	f["orderId"] = fmt.Sprintf("%d", orderID)
+	return f
+}
+
+// VendorOrderID sets order number with prefix and suffix
+func (f OrderFilter) VendorOrderID(vendorOrderID string) OrderFilter {
+	f["vendorOrderId"] = vendorOrderID
+	return f
+}
+
+// Email sets customer email
+func (f OrderFilter) Email(email string) OrderFilter {
+	f["email"] = email
+	return f
+}
+
+// CustomerID sets customer ID
+func (f OrderFilter) CustomerID(customerID ID) OrderFilter {
+	f["customerId"] = fmt.Sprintf("%d", customerID)
+	return f
+}
+
+// PaymentMethod sets payment method name
+func (f OrderFilter) PaymentMethod(paymentMethod string) OrderFilter {
+	f["paymentMethod"] = paymentMethod
+	return f
+}
+
+// ShippingMethod sets shipping method name
+func (f OrderFilter) ShippingMethod(shippingMethod string) OrderFilter {
+	f["shippingMethod"] = shippingMethod
+	return f
+}
+
+// PaymentStatus filters orders by any of payment statuses
+func (f OrderFilter) PaymentStatus(statuses ...PaymentStatus) OrderFilter {
+	s := make([]string, len(statuses))
+	for i, status := range statuses {
+		s[i] = string(status)
+	}
+	f["paymentStatus"] = strings.Join(s, ",")
+	return f
+}
+
+// FulfillmentStatus filters orders by any of fulfillment statuses
+func (f OrderFilter) FulfillmentStatus(statuses ...FulfillmentStatus) OrderFilter {
+	s := make([]string, len(statuses))
+	for i, status := range statuses {
+		s[i] = string(status)
+	}
+	f["fulfillmentStatus"] = strings.Join(s, ",")
+	return f
+}
+
+// AcceptMarketing filters orders by customer marketing agreement
+func (f OrderFilter) AcceptMarketing(acceptMarketing bool) OrderFilter {
+	f["acceptMarketing"] = strconv.FormatBool(acceptMarketing)
+	return f
+}
+
+// RefererID sets referer ID
+func (f OrderFilter) RefererID(refererID string) OrderFilter {
+	f["refererId"] = refererID
+	return f
+}
+
+// ProductID filters orders containing product
+func (f OrderFilter) ProductID(productID ID) OrderFilter {
+	f["productId"] = fmt.Sprintf("%d", productID)
+	return f
+}
+
+// Offset sets offset from the beginning of the returned items list
+func (f OrderFilter) Offset(offset uint) OrderFilter {
+	f["offset"] = fmt.Sprintf("%d", offset)
+	return f
+}
+
+// Limit sets maximum number of returned items, maximum allowed value is 100
+func (f OrderFilter) Limit(limit uint) OrderFilter {
+	f["limit"] = fmt.Sprintf("%d", limit)
+	return f
+}
+
+// Validate checks ranges, dates and IDs of filter.
+// Statuses are left to Ecwid, it may know more of them than the library.
+// Returned error wraps ErrInvalidFilter
+func (f OrderFilter) Validate() error {
+	if err := validateNumberRange(f, "totalFrom", "totalTo"); err != nil {
+		return err
+	}
+	if err := validateTimeRange(f, "createdFrom", "createdTo"); err != nil {
+		return err
+	}
+	if err := validateTimeRange(f, "updatedFrom", "updatedTo"); err != nil {
+		return err
+	}
+	for _, key := range []string{"orderId", "customerId", "productId"} {
+		if err := validateIDs(f, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package ecwid
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderFilterTestSuite struct {
+	ClientTestSuite
+}
+
+func TestOrderFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderFilterTestSuite))
+}
+
+func (suite *OrderFilterTestSuite) TestOrderFilter() {
+	from := time.Date(2015, 9, 20, 19, 59, 43, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	filter := NewOrderFilter().
+		Keywords("john").
+		TotalFrom(MustParseMoney("10")).
+		TotalTo(MustParseMoney("100.5")).
+		Created(from, to).
+		Updated(time.Time{}, to).
+		CouponCode("SALE").
+		Email("john@example.com").
+		CustomerID(7).
+		PaymentStatus(PaymentPaid, PaymentRefunded).
+		FulfillmentStatus(FulfillmentShipped).
+		RefererID("ref").
+		ProductID(9).
+		Limit(10)
+
+	suite.Nil(filter.Validate())
+	suite.Equal(OrderFilter{
+		"keywords":          "john",
+		"totalFrom":         "10",
+		"totalTo":           "100.5",
+		"createdFrom":       "1442779183",
+		"createdTo":         "1442782783",
+		"updatedTo":         "1442782783",
+		"couponCode":        "SALE",
+		"email":             "john@example.com",
+		"customerId":        "7",
+		"paymentStatus":     "PAID,REFUNDED",
+		"fulfillmentStatus": "SHIPPED",
+		"refererId":         "ref",
+		"productId":         "9",
+		"limit":             "10",
+	}, filter)
+}
+
+func (suite *OrderFilterTestSuite) TestOrderFilterValidate() {
+	from := time.Date(2015, 9, 20, 19, 59, 43, 0, time.UTC)
+
+	for name, filter := range map[string]OrderFilter{
+		"total range":   NewOrderFilter().TotalFrom(NewMoney(10)).TotalTo(NewMoney(5)),
+		"total number":  OrderFilter{"totalFrom": "ten"},
+		"created range": NewOrderFilter().Created(from, from.Add(-time.Second)),
+		"updated date":  OrderFilter{"updatedFrom": "yesterday"},
+		"customer id":   OrderFilter{"customerId": "john"},
+	} {
+		suite.True(errors.Is(filter.Validate(), ErrInvalidFilter), name)
+	}
+
+	for _, date := range []string{"1442779183", "2015-09-20 19:59:43 +0000", "2015-09-20 19:59:43", "2015-09-20", "2015-09-20T19:59:43Z"} {
+		suite.Nil(OrderFilter{"createdFrom": date}.Validate(), date)
+	}
+	suite.Nil(NewOrderFilter().PaymentStatus(PaymentPaid, "CUSTOM").FulfillmentStatus("SENT").Validate(), "unknown statuses are left to Ecwid")
+}
+
+func (suite *OrderFilterTestSuite) TestOrdersSearchValidate() {
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+			return httpmock.NewStringResponse(200, "{}"), nil
+		})
+
+	_, err := suite.client.OrdersSearch(OrderFilter{"customerId": "john"})
+	suite.True(errors.Is(err, ErrInvalidFilter))
+	suite.False(requested, "request must not be sent")
+
+	err = suite.client.OrdersTrampoline(NewOrderFilter().TotalFrom(NewMoney(5)).TotalTo(NewMoney(1)), func(uint, *Order) error {
+		return nil
+	})
+	suite.True(errors.Is(err, ErrInvalidFilter))
+	suite.False(requested, "request must not be sent")
+}
+
+func (suite *OrderFilterTestSuite) TestOrdersSearchRawFilter() {
+	filter := map[string]string{
+		"createdFrom":       "2015-04-22",
+		"paymentStatus":     "PAID,CUSTOM_STATUS",
+		"fulfillmentStatus": "SOME_NEW_STATUS",
+	}
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			values := req.URL.Query()
+			for key, value := range filter {
+				suite.Equal(value, values.Get(key), key)
+			}
+
+			return httpmock.NewStringResponse(200, `{"total":0,"count":0,"items":[]}`), nil
+		})
+
+	_, err := suite.client.OrdersSearch(filter)
+	suite.Nil(err)
+	suite.Truef(requested, "previously valid raw filter must be sent")
+}
@@ -0,0 +1,51 @@
+package ecwid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// OrderItemOptionFileUpload uploads file to order item option of FILES type from stream
+// returns new fileId
+func (c *Client) OrderItemOptionFileUpload(orderID, itemID ID, optionName string, file io.Reader, fileName string) (ID, error) {
+	response, err := c.R().
+		SetQueryParam("fileName", fileName).
+		SetHeader("Content-Type", "application/octet-stream").
+		SetBody(file).
+		Post(fmt.Sprintf("/orders/%d/items/%d/options/%s", orderID, itemID, url.PathEscape(optionName)))
+
+	return responseAdd(response, err)
+}
+
+// OrderItemOptionFileUploadFile uploads local file to order item option of FILES type
+// returns new fileId
+func (c *Client) OrderItemOptionFileUploadFile(orderID, itemID ID, optionName string, filename string) (ID, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	return c.OrderItemOptionFileUpload(orderID, itemID, optionName, bufio.NewReader(file), filepath.Base(filename))
+}
+
+// OrderItemOptionFileDelete deletes file from order item option by file id
+func (c *Client) OrderItemOptionFileDelete(orderID, itemID ID, optionName string, fileID ID) error {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/orders/%d/items/%d/options/%s/files/%d", orderID, itemID, url.PathEscape(optionName), fileID))
+
+	_, err = responseDelete(response, err)
+	return err
+}
+
+// OrderItemOptionFilesDeleteAll deletes all files from order item option
+func (c *Client) OrderItemOptionFilesDeleteAll(orderID, itemID ID, optionName string) (uint, error) {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/orders/%d/items/%d/options/%s/files", orderID, itemID, url.PathEscape(optionName)))
+
+	return responseDelete(response, err)
+}
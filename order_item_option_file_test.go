@@ -0,0 +1,126 @@
+package ecwid
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderItemOptionFileTestSuite struct {
+	ClientTestSuite
+}
+
+func TestOrderItemOptionFileTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderItemOptionFileTestSuite))
+}
+
+func (suite *OrderItemOptionFileTestSuite) TestOrderItemOptionFileUploadFile() {
+	const (
+		orderID  ID = 999
+		itemID   ID = 888
+		fileID   ID = 777
+		option      = "Your photo"
+		fileName    = "fixture/ecwid.jpg"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/orders/%d/items/%d/options/%s", storeID, orderID, itemID, "Your%20photo")
+	requested := false
+
+	content, err := ioutil.ReadFile(fileName)
+	suite.Nil(err)
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("POST", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/octet-stream", req.Header.Get("Content-Type"), "Content-Type")
+			suite.Equal("ecwid.jpg", req.URL.Query().Get("fileName"), "fileName")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			suite.Equal(content, body)
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, fileID)), nil
+		})
+
+	id, err := suite.client.OrderItemOptionFileUploadFile(orderID, itemID, option, fileName)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(fileID, id, "id")
+}
+
+func (suite *OrderItemOptionFileTestSuite) TestOrderItemOptionFileUploadFileNotFound() {
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+			return httpmock.NewStringResponse(400, `{"errorMessage":"ignore me"}`), nil
+		})
+
+	_, err := suite.client.OrderItemOptionFileUploadFile(1, 2, "photo", "fixture/notfound.jpg")
+	suite.True(os.IsNotExist(err))
+	suite.Falsef(requested, "request failed")
+}
+
+func (suite *OrderItemOptionFileTestSuite) TestOrderItemOptionFileDelete() {
+	const (
+		orderID ID = 999
+		itemID  ID = 888
+		fileID  ID = 777
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/orders/%d/items/%d/options/photo/files/%d", storeID, orderID, itemID, fileID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":1}`), nil
+		})
+
+	err := suite.client.OrderItemOptionFileDelete(orderID, itemID, "photo", fileID)
+	suite.Truef(requested, "request failed")
+	suite.Nil(err)
+}
+
+func (suite *OrderItemOptionFileTestSuite) TestOrderItemOptionFilesDeleteAll() {
+	const (
+		orderID ID = 999
+		itemID  ID = 888
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/orders/%d/items/%d/options/photo/files", storeID, orderID, itemID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":3}`), nil
+		})
+
+	count, err := suite.client.OrderItemOptionFilesDeleteAll(orderID, itemID, "photo")
+	suite.Truef(requested, "request failed")
+	suite.Nil(err)
+	suite.Equal(uint(3), count, "deleteCount")
+}
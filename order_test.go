@@ -3,9 +3,11 @@ package ecwid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -169,3 +171,118 @@ func (suite *OrderTestSuite) TestOrderUpdate() {
 
 	suite.Nil(err)
 }
+
+func (suite *OrderTestSuite) TestOrderAdd() {
+	const (
+		orderID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/orders", storeID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("POST", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			var o NewOrder
+			err = json.Unmarshal(body, &o)
+			suite.Nil(err)
+
+			suite.Equal("buyer@example.com", o.Email, "email")
+			suite.Equal("19.99", o.Total.String(), "total")
+			suite.Equal("marketplace-1", o.ExternalOrderID, "external order id")
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d,"orderid":"XJ12H"}`, orderID)), nil
+		})
+
+	id, err := suite.client.OrderAdd(&NewOrder{
+		Email:           "buyer@example.com",
+		Total:           MustParseMoney("19.99"),
+		ExternalOrderID: "marketplace-1",
+	})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(orderID, id, "id")
+}
+
+func (suite *OrderTestSuite) TestOrderDelete() {
+	const (
+		orderID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/orders/%d", storeID, orderID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":1}`), nil
+		})
+
+	err := suite.client.OrderDelete(orderID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
+
+func (suite *OrderTestSuite) TestOrderDeleteNotFound() {
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errorMessage":"Order not found"}`))
+
+	err := suite.client.OrderDelete(999)
+	suite.True(errors.Is(err, ErrNotFound))
+}
+
+func (suite *OrderTestSuite) TestOrderInvoiceGet() {
+	const (
+		orderID ID = 999
+		invoice    = "<html><body>Invoice</body></html>"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/orders/%d/invoice", storeID, orderID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("GET", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, invoice), nil
+		})
+
+	html, err := suite.client.OrderInvoiceGet(orderID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(invoice, string(html))
+
+	filename := filepath.Join(suite.T().TempDir(), "invoice.html")
+	err = suite.client.OrderInvoiceGetFile(orderID, filename)
+	suite.Nil(err)
+
+	saved, err := ioutil.ReadFile(filename)
+	suite.Nil(err)
+	suite.Equal(invoice, string(saved))
+}
+
+func (suite *OrderTestSuite) TestOrderInvoiceGetNotFound() {
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(404, `{"errorMessage":"Order not found"}`))
+
+	_, err := suite.client.OrderInvoiceGet(999)
+	suite.True(errors.Is(err, ErrNotFound))
+}
@@ -9,13 +9,13 @@ type (
 		Code             string                     `json:"code"`             // Coupon code
 		Type             DiscountCouponType         `json:"discountType"`     // Discount type: ABS, PERCENT, SHIPPING, ABS_AND_SHIPPING, PERCENT_AND_SHIPPING
 		Status           DiscountCouponStatus       `json:"status"`           // Discount coupon state: ACTIVE, PAUSED, EXPIRED or USEDUP
-		Discount         float32                    `json:"discount"`         // Discount amount
-		LaunchDate       string                     `json:"launchDate"`       // The date of coupon launch, e.g. 2014-06-06 08:00:00 +0000
-		ExpirationDate   string                     `json:"expirationDate"`   // Coupon expiration date, e.g. 2014-06-06 08:00:00 +0000
-		TotalLimit       float32                    `json:"totalLimit"`       // The minimum order subtotal the coupon applies to
+		Discount         Money                      `json:"discount"`         // Discount amount
+		LaunchDate       DateTime                   `json:"launchDate"`       // The date of coupon launch, e.g. 2014-06-06 08:00:00 +0000
+		ExpirationDate   DateTime                   `json:"expirationDate"`   // Coupon expiration date, e.g. 2014-06-06 08:00:00 +0000
+		TotalLimit       Money                      `json:"totalLimit"`       // The minimum order subtotal the coupon applies to
 		UsesLimit        DiscountCouponUseLimit     `json:"usesLimit"`        // Number of uses limitation: UNLIMITED, ONCEPERCUSTOMER, SINGLE
 		ApplicationLimit string                     `json:"applicationLimit"` // Application limit for discount coupons. Possible values: "UNLIMITED", "NEW_CUSTOMER_ONLY", "REPEAT_CUSTOMER_ONLY"
-		CreationDate     string                     `json:"creationDate"`     // Coupon creation date
+		CreationDate     DateTime                   `json:"creationDate"`     // Coupon creation date
 		OrderCount       uint                       `json:"orderCount"`       // Number of uses
 		CatalogLimit     DiscountCouponCatalogLimit `json:"catalogLimit"`     // Products and categories the coupon can be applied to
 	}
@@ -42,27 +42,27 @@ type (
 
 	// ShippingOptionInfo contains information about selected shipping option
 	ShippingOptionInfo struct {
-		ShippingCarrierName  string  `json:"shippingCarrierName"`  // Optional. Is present for orders made with carriers, e.g. USPS or shipping applications.
-		ShippingMethodName   string  `json:"shippingMethodName"`   // Shipping option name
-		ShippingRate         float32 `json:"shippingRate"`         // Rate
-		EstimatedTransitTime string  `json:"estimatedTransitTime"` // Delivery time estimation. Possible formats: number “5”, several days estimate “4-9”
-		IsPickup             bool    `json:"isPickup"`             // true if selected shipping option is local pickup. false otherwise
-		PickupInstruction    string  `json:"pickupInstruction"`    // Instruction for customer on how to receive their products
+		ShippingCarrierName  string `json:"shippingCarrierName"`  // Optional. Is present for orders made with carriers, e.g. USPS or shipping applications.
+		ShippingMethodName   string `json:"shippingMethodName"`   // Shipping option name
+		ShippingRate         Money  `json:"shippingRate"`         // Rate
+		EstimatedTransitTime string `json:"estimatedTransitTime"` // Delivery time estimation. Possible formats: number “5”, several days estimate “4-9”
+		IsPickup             bool   `json:"isPickup"`             // true if selected shipping option is local pickup. false otherwise
+		PickupInstruction    string `json:"pickupInstruction"`    // Instruction for customer on how to receive their products
 	}
 
 	// HandlingFeeInfo contains handling fee details
 	HandlingFeeInfo struct {
-		Name        string  `json:"name"`        //	Handling fee name set by store admin. E.g. Wrapping
-		Value       float32 `json:"value"`       //	Handling fee value
-		Description string  `json:"description"` //	Handling fee description for customer
+		Name        string `json:"name"`        //	Handling fee name set by store admin. E.g. Wrapping
+		Value       Money  `json:"value"`       //	Handling fee value
+		Description string `json:"description"` //	Handling fee description for customer
 	}
 
 	// DiscountInfo contains information about applied discounts (coupons are not included)
 	DiscountInfo struct {
-		Value       float32          `json:"value"`       // Discount value
+		Value       Money            `json:"value"`       // Discount value
 		Type        DiscountInfoType `json:"type"`        // Discount type: ABS or PERCENT
 		Base        DiscountInfoBase `json:"base"`        // Discount base, one of ON_TOTAL, ON_MEMBERSHIP, ON_TOTAL_AND_MEMBERSHIP, CUSTOM
-		OrderTotal  float32          `json:"orderTotal"`  // Minimum order subtotal the discount applies to
+		OrderTotal  Money            `json:"orderTotal"`  // Minimum order subtotal the discount applies to
 		Description string           `json:"description"` // Description of a discount (for discounts with base == CUSTOM)
 	}
 
@@ -78,7 +78,7 @@ type (
 	PredictedPackage struct {
 		ProductDimensions
 		Weight        float32 `json:"weight"`        // Total weight of a predicted package
-		DeclaredValue float32 `json:"declaredValue"` // Declared value of a predicted package (subtotal of items in package)
+		DeclaredValue Money   `json:"declaredValue"` // Declared value of a predicted package (subtotal of items in package)
 	}
 
 	// ExtraFieldsInfo Additional optional information about order.
@@ -90,7 +90,7 @@ type (
 		Date   DateTime `json:"date"`   //The date/time of a refund, e.g 2014-06-06 18:57:19 +0000
 		Source string   `json:"source"` //What action triggered refund. Possible values: "CP" - changed my merchant in Ecwid CP, "API" - changed by another app, "External" - refund made from payment processor website
 		Reason string   `json:"reason"` //A text reason for a refund. 256 characters max
-		Amount float32  `json:"amount"` //Amount of this specific refund (not total amount refunded for order. see redundedAmount field)
+		Amount Money    `json:"amount"` //Amount of this specific refund (not total amount refunded for order. see redundedAmount field)
 	}
 
 	// TaxOnShipping taxes applied to shipping 'as is’. null for old orders,
@@ -100,7 +100,7 @@ type (
 	TaxOnShipping struct {
 		Name  string  `json:"name"`
 		Value float32 `json:"value"`
-		Total float32 `json:"total"`
+		Total Money   `json:"total"`
 	}
 
 	// OrderItem fields
@@ -126,7 +126,7 @@ type (
 	// If sent in update order request, other fields will be regenerated based on information in this field
 	SelectionInfo struct {
 		SelectionTitle        string       `json:"selectionTitle"`
-		SelectionModifier     float32      `json:"selectionModifier"` // Money or Percent
+		SelectionModifier     Money        `json:"selectionModifier"` // Money or Percent
 		SelectionModifierType ModifierType `json:"selectionModifierType"`
 	}
 
@@ -134,9 +134,9 @@ type (
 	OrderItemTax struct {
 		Name                    string  `json:"name"`
 		Value                   float32 `json:"value"`
-		Total                   float32 `json:"total"`
-		TaxOnDiscountedSubtotal float32 `json:"taxOnDiscountedSubtotal"`
-		TaxOnShipping           float32 `json:"taxOnShipping"`
+		Total                   Money   `json:"total"`
+		TaxOnDiscountedSubtotal Money   `json:"taxOnDiscountedSubtotal"`
+		TaxOnShipping           Money   `json:"taxOnShipping"`
 		IncludeInPrice          bool    `json:"includeInPrice"`
 	}
 )
@@ -0,0 +1,103 @@
+package ecwid
+
+import (
+	"context"
+	"fmt"
+)
+
+// WithPrefetch returns a shallow copy of the client
+// whose trampolines fetch pages in parallel by concurrency requests
+// after the first page. Items are still delivered in order.
+// concurrency less than 2 disables prefetching
+func (c *Client) WithPrefetch(concurrency int) *Client {
+	client := *c
+	client.prefetch = concurrency
+	return &client
+}
+
+func prefetchTrampoline[T any](ctx context.Context, concurrency int, filter map[string]string, fetch pageFetcher[T], fn func(uint, T) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	index := uint(0)
+	deliver := func(items []T) error {
+		for _, item := range items {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(index, item); err != nil {
+				return err
+			}
+			index++
+		}
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	items, resp, err := fetch(ctx, copyFilter(filter))
+	if err != nil {
+		return err
+	}
+	if err := deliver(items); err != nil {
+		return err
+	}
+
+	offsets := make([]uint, 0)
+	if resp.Count > 0 {
+		for offset := resp.Offset + resp.Count; offset < resp.Total; offset += resp.Count {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	type page struct {
+		items []T
+		err   error
+	}
+
+	pages := make([]chan page, len(offsets))
+	for i := range pages {
+		pages[i] = make(chan page, 1)
+	}
+
+	// sem bounds both requests in flight and fetched but not delivered pages
+	sem := make(chan struct{}, concurrency)
+	go func() {
+		for i, offset := range offsets {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			filterCopy := copyFilter(filter)
+			filterCopy["offset"] = fmt.Sprintf("%d", offset)
+
+			go func(result chan<- page) {
+				items, _, err := fetch(ctx, filterCopy)
+				result <- page{items, err}
+			}(pages[i])
+		}
+	}()
+
+	for _, result := range pages {
+		var p page
+		select {
+		case p = <-result:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		<-sem
+
+		if p.err != nil {
+			return p.err
+		}
+		if err := deliver(p.items); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package ecwid
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PrefetchTestSuite struct {
+	ClientTestSuite
+}
+
+func TestPrefetchTestSuite(t *testing.T) {
+	suite.Run(t, new(PrefetchTestSuite))
+}
+
+func (suite *PrefetchTestSuite) TestPrefetchTrampoline() {
+	actual := make([]int, 0, 25)
+	err := prefetchTrampoline(context.Background(), 4, map[string]string{"limit": "3"}, pages(25, 3, -1),
+		func(index uint, item int) error {
+			suite.Equal(uint(item), index, "index")
+			actual = append(actual, item)
+			return nil
+		})
+	suite.Nil(err)
+
+	suite.Len(actual, 25)
+	for i, item := range actual {
+		suite.Equal(i, item, "order")
+	}
+}
+
+func (suite *PrefetchTestSuite) TestPrefetchTrampolineError() {
+	actual := make([]int, 0, 25)
+	err := prefetchTrampoline(context.Background(), 4, nil, pages(25, 3, 9),
+		func(index uint, item int) error {
+			actual = append(actual, item)
+			return nil
+		})
+	suite.EqualError(err, "fail")
+	suite.Equal([]int{0, 1, 2, 3, 4, 5, 6, 7, 8}, actual)
+
+	stop := errors.New("stop")
+	err = prefetchTrampoline(context.Background(), 4, nil, pages(25, 3, -1),
+		func(index uint, item int) error {
+			if item == 7 {
+				return stop
+			}
+			return nil
+		})
+	suite.Equal(stop, err)
+}
+
+func (suite *PrefetchTestSuite) TestPrefetchTrampolineConcurrency() {
+	const concurrency = 3
+
+	var inFlight, peak int32
+	fetch := pages(30, 2, -1)
+	bounded := func(ctx context.Context, filter map[string]string) ([]int, *SearchResponse, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		return fetch(ctx, filter)
+	}
+
+	count := 0
+	err := prefetchTrampoline(context.Background(), concurrency, map[string]string{"limit": "2"}, bounded,
+		func(index uint, item int) error {
+			// slow consumer must not unbound fetched pages either
+			time.Sleep(time.Millisecond)
+			count++
+			return nil
+		})
+	suite.Nil(err)
+	suite.Equal(30, count)
+
+	suite.True(atomic.LoadInt32(&peak) <= concurrency, "peak %d requests in flight", atomic.LoadInt32(&peak))
+	suite.True(atomic.LoadInt32(&peak) > 1, "pages must be fetched in parallel")
+}
+
+func (suite *PrefetchTestSuite) TestPrefetchTrampolineCancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	actual := make([]int, 0, 25)
+	err := prefetchTrampoline(ctx, 4, map[string]string{"limit": "3"}, pages(25, 3, -1),
+		func(index uint, item int) error {
+			actual = append(actual, item)
+			if item == 4 {
+				cancel()
+			}
+			return nil
+		})
+	suite.True(errors.Is(err, context.Canceled), "%v", err)
+	suite.Equal([]int{0, 1, 2, 3, 4}, actual, "later items must not be delivered")
+
+	called := false
+	err = prefetchTrampoline(ctx, 4, nil, pages(25, 3, -1),
+		func(index uint, item int) error {
+			called = true
+			return nil
+		})
+	suite.True(errors.Is(err, context.Canceled), "%v", err)
+	suite.False(called, "cancelled before start")
+}
+
+func (suite *PrefetchTestSuite) TestProductsTrampolinePrefetch() {
+	var requestCount int32
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&requestCount, 1)
+
+			offset, _ := strconv.ParseUint(req.URL.Query().Get("offset"), 10, 64)
+			return httpmock.NewJsonResponse(200, ProductsSearchResponse{
+				SearchResponse: SearchResponse{
+					Total:  10,
+					Count:  1,
+					Offset: uint(offset),
+					Limit:  1,
+				},
+				Items: []*Product{
+					&Product{ID: ID(offset)},
+				},
+			})
+		})
+
+	ids := make([]ID, 0, 10)
+	err := suite.client.WithPrefetch(3).ProductsTrampoline(map[string]string{"limit": "1"}, func(index uint, product *Product) error {
+		ids = append(ids, product.ID)
+		return nil
+	})
+	suite.Nil(err)
+	suite.Equal(int32(10), atomic.LoadInt32(&requestCount))
+	suite.Equal([]ID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, ids)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"html/template"
+	"iter"
 )
 
 type (
@@ -14,16 +15,16 @@ type (
 		Sku                   string             `json:"sku,omitempty"`
 		Quantity              int                `json:"quantity"`
 		Unlimited             bool               `json:"unlimited"`
-		Price                 float32            `json:"price,omitempty"`
-		CompareToPrice        float32            `json:"compareToPrice,omitempty"`
+		Price                 Money              `json:"price,omitzero"`
+		CompareToPrice        Money              `json:"compareToPrice,omitzero"`
 		IsShippingRequired    bool               `json:"isShippingRequired"`
 		Weight                float32            `json:"weight,omitempty"`
 		ProductClassID        ID                 `json:"productClassId"`
-		Created               DateTime           `json:"created,omitempty"`
+		Created               DateTime           `json:"created,omitzero"`
 		Enabled               bool               `json:"enabled"`
 		WarningLimit          uint               `json:"warningLimit"`
 		FixedShippingRateOnly bool               `json:"fixedShippingRateOnly"`
-		FixedShippingRate     float32            `json:"fixedShippingRate,omitempty"`
+		FixedShippingRate     Money              `json:"fixedShippingRate,omitzero"`
 		Description           template.HTML      `json:"description,omitempty"`
 		SeoTitle              string             `json:"seoTitle,omitempty"`
 		SeoDescription        string             `json:"seoDescription,omitempty"`
@@ -46,17 +47,17 @@ type (
 		NewProduct
 		ID                                     ID                 `json:"id"`
 		InStock                                bool               `json:"inStock"`
-		DefaultDisplayedPrice                  float32            `json:"defaultDisplayedPrice"`
+		DefaultDisplayedPrice                  Money              `json:"defaultDisplayedPrice"`
 		DefaultDisplayedPriceFormatted         string             `json:"defaultDisplayedPriceFormatted"`
 		CompareToPriceFormatted                string             `json:"compareToPriceFormatted"`
-		CompareToPriceDiscount                 float32            `json:"compareToPriceDiscount"`
+		CompareToPriceDiscount                 Money              `json:"compareToPriceDiscount"`
 		CompareToPriceDiscountFormatted        string             `json:"compareToPriceDiscountFormatted"`
 		CompareToPriceDiscountPercent          float32            `json:"compareToPriceDiscountPercent"`
 		CompareToPriceDiscountPercentFormatted string             `json:"compareToPriceDiscountPercentFormatted"`
 		URL                                    string             `json:"url"`
 		Updated                                DateTime           `json:"updated"`
-		CreateTimestamp                        uint64             `json:"createTimestamp"`
-		UpdateTimestamp                        uint64             `json:"updateTimestamp"`
+		CreateTimestamp                        Timestamp          `json:"createTimestamp"`
+		UpdateTimestamp                        Timestamp          `json:"updateTimestamp"`
 		DefaultCombinationID                   ID                 `json:"defaultCombinationId"`
 		IsSampleProduct                        bool               `json:"isSampleProduct"`
 		Combinations                           []ProductVariation `json:"combinations"`
@@ -80,10 +81,10 @@ func (c *Client) ProductsSearch(filter map[string]string) (*ProductsSearchRespon
 	// createdFrom date, createdTo date, updatedFrom date, updatedTo date,
 	// enabled bool, inStock bool, onsale string,
 	// sku string, productId number, baseUrl string, cleanUrls bool,
-	// TODO Search Products: field, option, attribute ???
 	// field{attributeName}={attributeValues} field{attributeId}={attributeValues}
 	// option_{optionName}={optionValues}
 	// attribute_{attributeName}={attributeValues}
+	// see ProductFilter
 
 	response, err := c.R().
 		SetQueryParams(filter).
@@ -94,13 +95,15 @@ func (c *Client) ProductsSearch(filter map[string]string) (*ProductsSearchRespon
 }
 
 // Products 'iterable' by filtered store products
+//
+// Deprecated: errors are silently dropped, use ProductsIterator or ProductsSeq instead
 func (c *Client) Products(ctx context.Context, filter map[string]string) <-chan *Product {
 	prodChan := make(chan *Product)
 
 	go func() {
 		defer close(prodChan)
 
-		c.ProductsTrampoline(filter, func(index uint, product *Product) error {
+		c.WithContext(ctx).ProductsTrampoline(filter, func(index uint, product *Product) error {
 			// FIXME silent error. maybe prodChan <- nil ?
 			select {
 			case <-ctx.Done():
@@ -114,6 +117,24 @@ func (c *Client) Products(ctx context.Context, filter map[string]string) <-chan
 	return prodChan
 }
 
+// ProductsIterator iterates by filtered store products
+func (c *Client) ProductsIterator(filter map[string]string) *Iterator[*Product] {
+	return newIterator(filter, c.productsPage)
+}
+
+// ProductsSeq iterates by filtered store products with range-over-func
+func (c *Client) ProductsSeq(ctx context.Context, filter map[string]string) iter.Seq2[*Product, error] {
+	return c.ProductsIterator(filter).Seq(ctx)
+}
+
+func (c *Client) productsPage(ctx context.Context, filter map[string]string) ([]*Product, *SearchResponse, error) {
+	resp, err := c.WithContext(ctx).ProductsSearch(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Items, &resp.SearchResponse, nil
+}
+
 // ProductGet gets all details of a specific product in an Ecwid store by its ID
 func (c *Client) ProductGet(productID ID) (*Product, error) {
 	response, err := c.R().
@@ -135,8 +156,9 @@ func (c *Client) ProductAdd(product *NewProduct) (ID, error) {
 }
 
 // ProductUpdate update an existing product in an Ecwid store referring to its ID
-func (c *Client) ProductUpdate(productID ID, product *NewProduct) error {
-	response, err := c.R().
+// see WithLowStockNotification
+func (c *Client) ProductUpdate(productID ID, product *NewProduct, opts ...UpdateOption) error {
+	response, err := c.updateR(opts).
 		SetHeader("Content-Type", "application/json").
 		SetBody(product).
 		Put(fmt.Sprintf("/products/%d", productID))
@@ -157,8 +179,11 @@ func (c *Client) ProductDelete(productID ID) error {
 }
 
 // ProductInventoryAdjust increase or decrease the product’s stock quantity by a delta quantity
-func (c *Client) ProductInventoryAdjust(productID ID, quantityDelta int) (int, error) {
-	response, err := c.R().
+// see WithLowStockNotification
+func (c *Client) ProductInventoryAdjust(productID ID, quantityDelta int, opts ...UpdateOption) (int, error) {
+	// delta must not be applied twice, see RetryPolicy
+	response, err := c.updateR(opts).
+		SetContext(rateLimitedRetryOnly(c.Context())).
 		SetHeader("Content-Type", "application/json").
 		SetBody(fmt.Sprintf(`{"quantityDelta":%d}`, quantityDelta)).
 		Put(fmt.Sprintf("/products/%d/inventory", productID))
@@ -0,0 +1,148 @@
+package ecwid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// ProductFilter builds filter of ProductsSearch, Products, ProductsTrampoline, etc.
+	// It is a map[string]string, so it can be passed anywhere a filter is expected:
+	//
+	//	client.ProductsSearch(ecwid.NewProductFilter().Keyword("shirt").InStock(true))
+	ProductFilter map[string]string
+
+	// ProductSortBy is sort order of ProductsSearch
+	ProductSortBy string
+)
+
+// ProductSortBy orders
+const (
+	ProductSortRelevance           ProductSortBy = "RELEVANCE"
+	ProductSortDefinedByStoreOwner ProductSortBy = "DEFINED_BY_STORE_OWNER"
+	ProductSortAddedTimeDesc       ProductSortBy = "ADDED_TIME_DESC"
+	ProductSortAddedTimeAsc        ProductSortBy = "ADDED_TIME_ASC"
+	ProductSortNameAsc             ProductSortBy = "NAME_ASC"
+	ProductSortNameDesc            ProductSortBy = "NAME_DESC"
+	ProductSortPriceAsc            ProductSortBy = "PRICE_ASC"
+	ProductSortPriceDesc           ProductSortBy = "PRICE_DESC"
+	ProductSortUpdatedTimeAsc      ProductSortBy = "UPDATED_TIME_ASC"
+	ProductSortUpdatedTimeDesc     ProductSortBy = "UPDATED_TIME_DESC"
+)
+
+// NewProductFilter creates empty ProductFilter
+func NewProductFilter() ProductFilter {
+	return make(ProductFilter)
+}
+
+// Keyword searches term in product name, description, SKU, options, category name, gallery image descriptions, attribute values
+func (f ProductFilter) Keyword(keyword string) ProductFilter {
+	f["keyword"] = keyword
+	return f
+}
+
+// PriceFrom sets minimum product price
+func (f ProductFilter) PriceFrom(price Money) ProductFilter {
+	f["priceFrom"] = price.String()
+	return f
+}
+
+// PriceTo sets maximum product price
+func (f ProductFilter) PriceTo(price Money) ProductFilter {
+	f["priceTo"] = price.String()
+	return f
+}
+
+// Category sets category ID, withSubcategories includes products from subcategories
+func (f ProductFilter) Category(categoryID ID, withSubcategories bool) ProductFilter {
+	f["category"] = fmt.Sprintf("%d", categoryID)
+	f["withSubcategories"] = strconv.FormatBool(withSubcategories)
+	return f
+}
+
+// Created sets product creation date range, zero bound is omitted
+func (f ProductFilter) Created(from, to time.Time) ProductFilter {
+	setTimeRange(f, "createdFrom", "createdTo", from, to)
+	return f
+}
+
+// Updated sets product last update date range, zero bound is omitted
+func (f ProductFilter) Updated(from, to time.Time) ProductFilter {
+	setTimeRange(f, "updatedFrom", "updatedTo", from, to)
+	return f
+}
+
+// Enabled filters enabled or disabled products
+func (f ProductFilter) Enabled(enabled bool) ProductFilter {
+	f["enabled"] = strconv.FormatBool(enabled)
+	return f
+}
+
+// InStock filters products in stock or out of stock
+func (f ProductFilter) InStock(inStock bool) ProductFilter {
+	f["inStock"] = strconv.FormatBool(inStock)
+	return f
+}
+
+// OnSale filters products on sale or not on sale
+func (f ProductFilter) OnSale(onSale bool) ProductFilter {
+	if onSale {
+		f["onsale"] = "onsale"
+	} else {
+		f["onsale"] = "notonsale"
+	}
+	return f
+}
+
+// Sku sets product or variation SKU, exact match
+func (f ProductFilter) Sku(sku string) ProductFilter {
+	f["sku"] = sku
+	return f
+}
+
+// ProductIDs filters products by IDs
+func (f ProductFilter) ProductIDs(productIDs ...ID) ProductFilter {
+	f["productId"] = joinIDs(productIDs)
+	return f
+}
+
+// SortBy sets sort order
+func (f ProductFilter) SortBy(sortBy ProductSortBy) ProductFilter {
+	f["sortBy"] = string(sortBy)
+	return f
+}
+
+// Offset sets offset from the beginning of the returned items list
+func (f ProductFilter) Offset(offset uint) ProductFilter {
+	f["offset"] = fmt.Sprintf("%d", offset)
+	return f
+}
+
+// Limit sets maximum number of returned items, maximum allowed value is 100
+func (f ProductFilter) Limit(limit uint) ProductFilter {
+	f["limit"] = fmt.Sprintf("%d", limit)
+	return f
+}
+
+// Field filters products by attribute or option values:
+// field{attributeName}={attributeValues}
+func (f ProductFilter) Field(name string, values ...string) ProductFilter {
+	f["field"+name] = strings.Join(values, ",")
+	return f
+}
+
+// Option filters products by option values:
+// option_{optionName}={optionValues}
+func (f ProductFilter) Option(name string, values ...string) ProductFilter {
+	f["option_"+name] = strings.Join(values, ",")
+	return f
+}
+
+// Attribute filters products by attribute values:
+// attribute_{attributeName}={attributeValues}
+func (f ProductFilter) Attribute(name string, values ...string) ProductFilter {
+	f["attribute_"+name] = strings.Join(values, ",")
+	return f
+}
@@ -0,0 +1,79 @@
+package ecwid
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductFilterTestSuite struct {
+	ClientTestSuite
+}
+
+func TestProductFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductFilterTestSuite))
+}
+
+func (suite *ProductFilterTestSuite) TestProductFilter() {
+	created := time.Date(2015, 9, 20, 19, 59, 43, 0, time.UTC)
+
+	filter := NewProductFilter().
+		Keyword("shirt").
+		PriceFrom(MustParseMoney("10")).
+		PriceTo(MustParseMoney("99.95")).
+		Category(5, true).
+		Created(created, time.Time{}).
+		Enabled(true).
+		InStock(false).
+		OnSale(false).
+		Sku("SKU-1").
+		ProductIDs(1, 2, 3).
+		SortBy(ProductSortPriceAsc).
+		Offset(10).
+		Limit(20).
+		Field("Brand", "Acme").
+		Option("Size", "S", "M").
+		Attribute("Color", "red")
+
+	suite.Equal(ProductFilter{
+		"keyword":           "shirt",
+		"priceFrom":         "10",
+		"priceTo":           "99.95",
+		"category":          "5",
+		"withSubcategories": "true",
+		"createdFrom":       "1442779183",
+		"enabled":           "true",
+		"inStock":           "false",
+		"onsale":            "notonsale",
+		"sku":               "SKU-1",
+		"productId":         "1,2,3",
+		"sortBy":            "PRICE_ASC",
+		"offset":            "10",
+		"limit":             "20",
+		"fieldBrand":        "Acme",
+		"option_Size":       "S,M",
+		"attribute_Color":   "red",
+	}, filter)
+}
+
+func (suite *ProductFilterTestSuite) TestProductsSearchFilter() {
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			values := req.URL.Query()
+			suite.Equal("S,M", values.Get("option_Size"), "option")
+			suite.Equal("onsale", values.Get("onsale"), "onsale")
+
+			return httpmock.NewStringResponse(200, "{}"), nil
+		})
+
+	_, err := suite.client.ProductsSearch(NewProductFilter().Option("Size", "S", "M").OnSale(true))
+	suite.Nil(err)
+	suite.Truef(requested, "request failed")
+}
@@ -7,10 +7,16 @@ import (
 	"os"
 )
 
-// ProductImageUpload uploads product image from stream
-func (c *Client) ProductImageUpload(productID ID, image io.Reader) (ID, error) {
+// ProductImageUpload uploads product image from stream,
+// Content-Type is detected from the data, see WithContentType
+func (c *Client) ProductImageUpload(productID ID, image io.Reader, opts ...ImageOption) (ID, error) {
+	image, contentType, err := detectImage(image, opts)
+	if err != nil {
+		return 0, err
+	}
+
 	response, err := c.R().
-		SetHeader("Content-Type", "image/jpeg").
+		SetHeader("Content-Type", contentType).
 		SetBody(image).
 		Post(fmt.Sprintf("/products/%d/image", productID))
 
@@ -18,14 +24,14 @@ func (c *Client) ProductImageUpload(productID ID, image io.Reader) (ID, error) {
 }
 
 // ProductImageUploadFile uploads product image from local image file
-func (c *Client) ProductImageUploadFile(productID ID, filename string) (ID, error) {
+func (c *Client) ProductImageUploadFile(productID ID, filename string, opts ...ImageOption) (ID, error) {
 	file, err := os.Open(filename)
 	if err != nil {
 		return 0, err
 	}
 	defer file.Close()
 
-	return c.ProductImageUpload(productID, bufio.NewReader(file))
+	return c.ProductImageUpload(productID, bufio.NewReader(file), append([]ImageOption{withFilename(filename)}, opts...)...)
 }
 
 // ProductImageUploadByURL uploads product image  from external resource
@@ -7,8 +7,14 @@ import (
 	"os"
 )
 
-// ProductImageGalleryUpload uploads image to product gallery from stream
-func (c *Client) ProductImageGalleryUpload(productID ID, image io.Reader, imageTitle string) (ID, error) {
+// ProductImageGalleryUpload uploads image to product gallery from stream,
+// Content-Type is detected from the data, see WithContentType
+func (c *Client) ProductImageGalleryUpload(productID ID, image io.Reader, imageTitle string, opts ...ImageOption) (ID, error) {
+	image, contentType, err := detectImage(image, opts)
+	if err != nil {
+		return 0, err
+	}
+
 	params := make(map[string]string)
 	if len(imageTitle) > 0 {
 		params["fileName"] = imageTitle
@@ -16,7 +22,7 @@ func (c *Client) ProductImageGalleryUpload(productID ID, image io.Reader, imageT
 
 	response, err := c.R().
 		SetQueryParams(params).
-		SetHeader("Content-Type", "image/jpeg").
+		SetHeader("Content-Type", contentType).
 		SetBody(image).
 		Post(fmt.Sprintf("/products/%d/gallery", productID))
 
@@ -24,14 +30,14 @@ func (c *Client) ProductImageGalleryUpload(productID ID, image io.Reader, imageT
 }
 
 // ProductImageGalleryUploadFile uploads image to product gallery from local file
-func (c *Client) ProductImageGalleryUploadFile(productID ID, filename string, imageTitle string) (ID, error) {
+func (c *Client) ProductImageGalleryUploadFile(productID ID, filename string, imageTitle string, opts ...ImageOption) (ID, error) {
 	file, err := os.Open(filename)
 	if err != nil {
 		return 0, err
 	}
 	defer file.Close()
 
-	return c.ProductImageGalleryUpload(productID, bufio.NewReader(file), imageTitle)
+	return c.ProductImageGalleryUpload(productID, bufio.NewReader(file), imageTitle, append([]ImageOption{withFilename(filename)}, opts...)...)
 }
 
 // ProductImageGalleryUploadByURL uploads image to product gallery from external url
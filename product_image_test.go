@@ -172,3 +172,78 @@ func (suite *ProductImageTestSuite) TestProductImageDelete() {
 
 	suite.Nil(err)
 }
+
+func (suite *ProductImageTestSuite) TestProductImageUploadPNG() {
+	const (
+		productID ID = 999
+		image        = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+	)
+
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("image/png", req.Header["Content-Type"][0], "Content-Type: image/png")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			suite.Equal(image, string(body))
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, productID)), nil
+		})
+
+	id, err := suite.client.ProductImageUpload(productID, strings.NewReader(image))
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(productID, id, "id")
+}
+
+func (suite *ProductImageTestSuite) TestProductImageUploadAVIF() {
+	const (
+		productID ID = 999
+		image        = "\x00\x00\x00\x1cftypavif\x00\x00\x00\x00avifmif1miaf"
+	)
+
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("image/avif", req.Header["Content-Type"][0], "Content-Type: image/avif")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			suite.Equal(image, string(body))
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, productID)), nil
+		})
+
+	id, err := suite.client.ProductImageUpload(productID, strings.NewReader(image), WithContentType("image/avif"))
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(productID, id, "id")
+}
+
+func (suite *ProductImageTestSuite) TestProductImageUploadNotImage() {
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			return httpmock.NewStringResponse(200, `{"id":1}`), nil
+		})
+
+	_, err := suite.client.ProductImageUpload(999, strings.NewReader("definitely not an image"))
+	suite.Equal(ErrNotImage, err)
+	suite.Falsef(requested, "request failed")
+
+	_, err = suite.client.ProductImageUpload(999, strings.NewReader("<html></html>"), WithContentType("image/png"))
+	suite.Equal(ErrNotImage, err, "override does not skip the check")
+	suite.Falsef(requested, "request failed")
+}
@@ -3,6 +3,7 @@ package ecwid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -108,6 +109,40 @@ func (suite *ProductTestSuite) TestProducts() {
 	suite.Equal("1", filter["offset"], "filter map must be unchanged")
 }
 
+func (suite *ProductTestSuite) TestProductsTrampolineCancel() {
+	requestCount := 0
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requestCount++
+
+			return httpmock.NewJsonResponse(200, ProductsSearchResponse{
+				SearchResponse: SearchResponse{
+					Total: 4,
+					Count: 2,
+				},
+				Items: []*Product{
+					&Product{},
+					&Product{},
+				},
+			})
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	visited := 0
+	err := suite.client.WithContext(ctx).ProductsTrampoline(nil, func(index uint, product *Product) error {
+		visited++
+		cancel()
+		return nil
+	})
+
+	suite.True(errors.Is(err, context.Canceled))
+	suite.Equal(1, requestCount, "must stop before next page")
+	suite.Equal(1, visited, "must stop mid-page")
+}
+
 func (suite *ProductTestSuite) TestProductGet() {
 	const (
 		productID ID = 999
@@ -124,7 +159,8 @@ func (suite *ProductTestSuite) TestProductGet() {
 			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
 			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
 
-			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d, "sku":"sky"}`, productID)), nil
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d, "sku":"sky",
+				"options":[{"type":"SELECT","name":"Size","choices":[{"text":"XL","priceModifier":2.5,"priceModifierType":"ABSOLUTE"}]}]}`, productID)), nil
 		})
 
 	p, err := suite.client.ProductGet(productID)
@@ -133,6 +169,7 @@ func (suite *ProductTestSuite) TestProductGet() {
 	suite.Nil(err)
 	suite.Equal(productID, p.ID, "id")
 	suite.Equal("sky", p.Sku, "sku")
+	suite.Equal("2.5", p.Options[0].Choices[0].PriceModifier.String(), "priceModifier")
 }
 
 func (suite *ProductTestSuite) TestProductAdd() {
@@ -254,6 +291,7 @@ func (suite *ProductTestSuite) TestProductInventoryAdjust() {
 			err = json.Unmarshal(body, &d)
 			suite.Nil(err)
 			suite.Equal(-1, d.Delta, "delta")
+			suite.Empty(req.URL.Query().Get("checkLowStockNotification"), "checkLowStockNotification")
 
 			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
 		})
@@ -264,3 +302,21 @@ func (suite *ProductTestSuite) TestProductInventoryAdjust() {
 	suite.Nil(err)
 	suite.Equal(1, quantity, "quantity")
 }
+
+func (suite *ProductTestSuite) TestProductInventoryAdjustLowStockNotification() {
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("true", req.URL.Query().Get("checkLowStockNotification"), "checkLowStockNotification")
+
+			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
+		})
+
+	_, err := suite.client.ProductInventoryAdjust(999, -1, WithLowStockNotification())
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
@@ -12,7 +12,7 @@ type (
 	// (e.g. text, datepicker or upload file options)
 	ProductOptionChoice struct {
 		Text              string       `json:"text,omitempty"`
-		PriceModifier     int          `json:"priceModifier"`
+		PriceModifier     Money        `json:"priceModifier"` // Money or Percent
 		PriceModifierType ModifierType `json:"priceModifierType,omitempty"`
 	}
 
@@ -28,8 +28,8 @@ type (
 	// ShippingSettings of product
 	ShippingSettings struct {
 		Type            ShippingSettingsType `json:"type,omitempty"` // TODO One of: "GLOBAL_METHODS", "SELECTED_METHODS", "FLAT_RATE", "FREE_SHIPPING". "GLOBAL_METHODS"
-		MethodMarkup    float32              `json:"methodMarkup,omitempty"`
-		FlatRate        float32              `json:"flatRate,omitempty"`
+		MethodMarkup    Money                `json:"methodMarkup,omitzero"`
+		FlatRate        Money                `json:"flatRate,omitzero"`
 		DisabledMethods []string             `json:"disabledMethods,omitempty"`
 		EnabledMethods  []string             `json:"enabledMethods,omitempty"`
 	}
@@ -37,8 +37,8 @@ type (
 	// WholesalePrice is element of array of variation’s wholesale price tiers
 	// (quantity limit and price).
 	WholesalePrice struct {
-		Quantity uint    `json:"quantity"`
-		Price    float32 `json:"price,omitempty"`
+		Quantity uint  `json:"quantity"`
+		Price    Money `json:"price,omitzero"`
 	}
 
 	// RelatedCategory describes the “N random related products from a category” option
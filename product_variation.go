@@ -8,10 +8,10 @@ type (
 		Sku                string  `json:"sku,omitempty"`
 		Quantity           uint    `json:"quantity"`
 		Unlimited          bool    `json:"unlimited"`
-		Price              float32 `json:"price,omitempty"`
+		Price              Money   `json:"price,omitzero"`
 		Weight             float32 `json:"weight,omitempty"`
 		WarningLimit       uint    `json:"warningLimit"`
-		CompareToPrice     float32 `json:"compareToPrice,omitempty"`
+		CompareToPrice     Money   `json:"compareToPrice,omitzero"`
 		IsShippingRequired bool    `json:"isShippingRequired"`
 
 		Options         []OptionValue    `json:"options,omitempty"`
@@ -52,9 +52,9 @@ func (c *Client) ProductVariationGet(productID, variationID ID) (*ProductVariati
 }
 
 // ProductVariationUpdate update a specific product variation details referring to its ID
-func (c *Client) ProductVariationUpdate(productID, variationID ID, productVariation *NewProductVariation) error {
-	// TODO implement checkLowStockNotification
-	response, err := c.R().
+// see WithLowStockNotification
+func (c *Client) ProductVariationUpdate(productID, variationID ID, productVariation *NewProductVariation, opts ...UpdateOption) error {
+	response, err := c.updateR(opts).
 		SetHeader("Content-Type", "application/json").
 		SetBody(productVariation).
 		Put(fmt.Sprintf("/products/%d/combinations/%d", productID, variationID))
@@ -62,13 +62,44 @@ func (c *Client) ProductVariationUpdate(productID, variationID ID, productVariat
 	return responseUpdate(response, err)
 }
 
-// TODO implement product variations API
-// func (c *Client) ProductVariationAdd
-// func (c *Client) ProductVariationDelete
-// func (c *Client) ProductVariationsDelete
-// func (c *Client) ProductVariationInventoryAdjust
+// ProductVariationAdd creates a new product variation
+// returns new variation id
+func (c *Client) ProductVariationAdd(productID ID, productVariation *NewProductVariation) (ID, error) {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(productVariation).
+		Post(fmt.Sprintf("/products/%d/combinations", productID))
 
-// TODO implement product variations image API
-// func (c *Client) ProductVariationImageUpload
-// func (c *Client) ProductVariationImageUploadFile
-// func (c *Client) ProductVariationImageDelete
+	return responseAdd(response, err)
+}
+
+// ProductVariationDelete delete a specific product variation referring to its ID
+func (c *Client) ProductVariationDelete(productID, variationID ID) error {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/products/%d/combinations/%d", productID, variationID))
+
+	_, err = responseDelete(response, err)
+	return err
+}
+
+// ProductVariationsDelete delete all variations of a specific product
+// returns deleted variations count
+func (c *Client) ProductVariationsDelete(productID ID) (uint, error) {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/products/%d/combinations", productID))
+
+	return responseDelete(response, err)
+}
+
+// ProductVariationInventoryAdjust increase or decrease the product variation’s stock quantity by a delta quantity
+// see WithLowStockNotification
+func (c *Client) ProductVariationInventoryAdjust(productID, variationID ID, quantityDelta int, opts ...UpdateOption) (int, error) {
+	// delta must not be applied twice, see RetryPolicy
+	response, err := c.updateR(opts).
+		SetContext(rateLimitedRetryOnly(c.Context())).
+		SetHeader("Content-Type", "application/json").
+		SetBody(fmt.Sprintf(`{"quantityDelta":%d}`, quantityDelta)).
+		Put(fmt.Sprintf("/products/%d/combinations/%d/inventory", productID, variationID))
+
+	return responseUpdateCount(response, err)
+}
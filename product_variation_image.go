@@ -0,0 +1,53 @@
+package ecwid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ProductVariationImageUpload uploads product variation image from stream,
+// Content-Type is detected from the data, see WithContentType
+func (c *Client) ProductVariationImageUpload(productID, variationID ID, image io.Reader, opts ...ImageOption) (ID, error) {
+	image, contentType, err := detectImage(image, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := c.R().
+		SetHeader("Content-Type", contentType).
+		SetBody(image).
+		Post(fmt.Sprintf("/products/%d/combinations/%d/image", productID, variationID))
+
+	return responseAdd(response, err)
+}
+
+// ProductVariationImageUploadFile uploads product variation image from local image file
+func (c *Client) ProductVariationImageUploadFile(productID, variationID ID, filename string, opts ...ImageOption) (ID, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	return c.ProductVariationImageUpload(productID, variationID, bufio.NewReader(file), append([]ImageOption{withFilename(filename)}, opts...)...)
+}
+
+// ProductVariationImageUploadByURL uploads product variation image from external resource
+func (c *Client) ProductVariationImageUploadByURL(productID, variationID ID, imageURL string) (ID, error) {
+	response, err := c.R().
+		SetQueryParam("externalUrl", imageURL).
+		Post(fmt.Sprintf("/products/%d/combinations/%d/image", productID, variationID))
+
+	return responseAdd(response, err)
+}
+
+// ProductVariationImageDelete deletes the image of a product variation in an Ecwid store
+func (c *Client) ProductVariationImageDelete(productID, variationID ID) error {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/products/%d/combinations/%d/image", productID, variationID))
+
+	_, err = responseDelete(response, err)
+	return err
+}
@@ -0,0 +1,178 @@
+package ecwid
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductVariationImageTestSuite struct {
+	ClientTestSuite
+}
+
+func TestProductVariationImageTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductVariationImageTestSuite))
+}
+
+func (suite *ProductVariationImageTestSuite) TestProductVariationImageUpload() {
+	const (
+		productID   ID = 999
+		variationID ID = 555
+		imageFile      = "fixture/ecwid.jpg"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/products/%d/combinations/%d/image", storeID, productID, variationID)
+	requested := false
+
+	file, err := os.Open(imageFile)
+	suite.Nil(err)
+	defer file.Close()
+	image, err := ioutil.ReadAll(file)
+	suite.Nil(err)
+	file.Seek(0, 0)
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("POST", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("image/jpeg", req.Header["Content-Type"][0], "Content-Type: image/jpeg")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			suite.Equal(image, body)
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, variationID)), nil
+		})
+
+	id, err := suite.client.ProductVariationImageUpload(productID, variationID, file)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(variationID, id, "id")
+}
+
+func (suite *ProductVariationImageTestSuite) TestProductVariationImageUploadFile() {
+	const (
+		productID   ID = 999
+		variationID ID = 555
+		imageFile      = "fixture/ecwid.jpg"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/products/%d/combinations/%d/image", storeID, productID, variationID)
+	requested := false
+
+	file, err := os.Open(imageFile)
+	suite.Nil(err)
+	defer file.Close()
+	image, err := ioutil.ReadAll(file)
+	suite.Nil(err)
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("POST", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("image/jpeg", req.Header["Content-Type"][0], "Content-Type: image/jpeg")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			suite.Equal(image, body)
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, variationID)), nil
+		})
+
+	id, err := suite.client.ProductVariationImageUploadFile(productID, variationID, imageFile)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(variationID, id, "id")
+}
+
+func (suite *ProductVariationImageTestSuite) TestProductVariationImageUploadFileNotFound() {
+	const (
+		productID   ID = 999
+		variationID ID = 555
+		imageFile      = "fixture/notfound.jpg"
+	)
+
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			return httpmock.NewStringResponse(400, `{"errorMessage":"ignore me"}`), nil
+		})
+
+	_, err := suite.client.ProductVariationImageUploadFile(productID, variationID, imageFile)
+	suite.NotNil(err)
+	suite.Falsef(requested, "request failed")
+}
+
+func (suite *ProductVariationImageTestSuite) TestProductVariationImageUploadByURL() {
+	const (
+		productID   ID = 999
+		variationID ID = 555
+		imageURL       = "https://example.org/image.jpg"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/products/%d/combinations/%d/image", storeID, productID, variationID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("POST", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			values := req.URL.Query()
+
+			suite.Equal(imageURL, values.Get("externalUrl"), "externalUrl")
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, variationID)), nil
+		})
+
+	id, err := suite.client.ProductVariationImageUploadByURL(productID, variationID, imageURL)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(variationID, id, "id")
+}
+
+func (suite *ProductVariationImageTestSuite) TestProductVariationImageDelete() {
+	const (
+		productID   ID = 999
+		variationID ID = 555
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/products/%d/combinations/%d/image", storeID, productID, variationID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":1}`), nil
+		})
+
+	err := suite.client.ProductVariationImageDelete(productID, variationID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
@@ -0,0 +1,173 @@
+package ecwid
+
+import (
+	"sort"
+	"strings"
+	"text/template"
+)
+
+type (
+	// ProductVariationTemplate describes variations created by ProductVariationsSync
+	ProductVariationTemplate struct {
+		// Sku is text/template of variation SKU, executed with ProductVariationSkuData,
+		// e.g. `{{.Sku}}-{{index .Options "Size"}}`. Empty template leaves SKU to Ecwid
+		Sku       string
+		Price     Money // zero is product price
+		Quantity  uint
+		Unlimited bool
+		// DeleteStale deletes variations which options do not match any choice
+		DeleteStale bool
+	}
+
+	// ProductVariationSkuData is data of ProductVariationTemplate Sku template
+	ProductVariationSkuData struct {
+		Sku     string            // product SKU
+		Options map[string]string // option name => choice text
+		Index   int               // index of combination in matrix
+	}
+
+	// ProductVariationsReport is result of ProductVariationsSync,
+	// keys are option sets formatted by VariationKey
+	ProductVariationsReport struct {
+		Created map[string]ID    // key => new variation ID
+		Deleted map[string]ID    // key => deleted variation ID
+		Failed  map[string]error // key => error of add or delete
+	}
+)
+
+// VariationMatrix returns Cartesian product of SELECT and RADIO options choices,
+// options without choices are skipped
+func VariationMatrix(options []ProductOption) [][]OptionValue {
+	matrix := [][]OptionValue{nil}
+
+	for _, option := range options {
+		if option.Type != ProductOptionSelect && option.Type != ProductOptionRadio {
+			continue
+		}
+		if len(option.Choices) == 0 {
+			continue
+		}
+
+		next := make([][]OptionValue, 0, len(matrix)*len(option.Choices))
+		for _, values := range matrix {
+			for _, choice := range option.Choices {
+				combination := make([]OptionValue, len(values), len(values)+1)
+				copy(combination, values)
+				next = append(next, append(combination, OptionValue{Name: option.Name, Value: choice.Text}))
+			}
+		}
+		matrix = next
+	}
+
+	if len(matrix[0]) == 0 {
+		return nil
+	}
+	return matrix
+}
+
+// VariationKey formats option set independent of options order, e.g. "Color=Red, Size=XL"
+func VariationKey(options []OptionValue) string {
+	pairs := make([]string, len(options))
+	for i, option := range options {
+		pairs[i] = option.Name + "=" + option.Value
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
+// ProductVariationsSync creates product variations missing in VariationMatrix of product options
+// like template and deletes stale variations if template DeleteStale is set.
+// Error is returned if template is invalid or existing variations cannot be read,
+// failed creations and deletions are in report
+func (c *Client) ProductVariationsSync(product *Product, tmpl *ProductVariationTemplate) (*ProductVariationsReport, error) {
+	if tmpl == nil {
+		tmpl = &ProductVariationTemplate{}
+	}
+
+	var sku *template.Template
+	if len(tmpl.Sku) > 0 {
+		var err error
+		if sku, err = template.New("sku").Option("missingkey=error").Parse(tmpl.Sku); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := c.ProductVariationsGet(product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	matrix := VariationMatrix(product.Options)
+
+	// build all variations before any request, so template errors change nothing
+	variations := make(map[string]*NewProductVariation, len(matrix))
+	keys := make([]string, 0, len(matrix))
+	for index, options := range matrix {
+		key := VariationKey(options)
+		variation := &NewProductVariation{
+			Quantity:  tmpl.Quantity,
+			Unlimited: tmpl.Unlimited,
+			Price:     tmpl.Price,
+			Options:   options,
+		}
+
+		if sku != nil {
+			data := ProductVariationSkuData{
+				Sku:     product.Sku,
+				Options: make(map[string]string, len(options)),
+				Index:   index,
+			}
+			for _, option := range options {
+				data.Options[option.Name] = option.Value
+			}
+
+			var b strings.Builder
+			if err := sku.Execute(&b, data); err != nil {
+				return nil, err
+			}
+			variation.Sku = b.String()
+		}
+
+		variations[key] = variation
+		keys = append(keys, key)
+	}
+
+	report := &ProductVariationsReport{
+		Created: make(map[string]ID),
+		Deleted: make(map[string]ID),
+		Failed:  make(map[string]error),
+	}
+
+	exists := make(map[string]bool, len(existing))
+	for _, variation := range existing {
+		var options []OptionValue
+		if variation.NewProductVariation != nil {
+			options = variation.Options
+		}
+		key := VariationKey(options)
+		exists[key] = true
+
+		if _, ok := variations[key]; ok || !tmpl.DeleteStale {
+			continue
+		}
+		if err := c.ProductVariationDelete(product.ID, variation.ID); err != nil {
+			report.Failed[key] = err
+		} else {
+			report.Deleted[key] = variation.ID
+		}
+	}
+
+	for _, key := range keys {
+		if exists[key] {
+			continue
+		}
+		id, err := c.ProductVariationAdd(product.ID, variations[key])
+		if err != nil {
+			report.Failed[key] = err
+		} else {
+			report.Created[key] = id
+		}
+	}
+
+	return report, nil
+}
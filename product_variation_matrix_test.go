@@ -0,0 +1,139 @@
+package ecwid
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductVariationMatrixTestSuite struct {
+	ClientTestSuite
+}
+
+func TestProductVariationMatrixTestSuite(t *testing.T) {
+	suite.Run(t, new(ProductVariationMatrixTestSuite))
+}
+
+func matrixProduct() *Product {
+	product := &Product{ID: 999}
+	product.Sku = "TEE"
+	product.Options = []ProductOption{
+		{Type: ProductOptionSelect, Name: "Color", Choices: []ProductOptionChoice{{Text: "Red"}, {Text: "Blue"}}},
+		{Type: ProductOptionTextfield, Name: "Engraving"},
+		{Type: ProductOptionRadio, Name: "Size", Choices: []ProductOptionChoice{{Text: "S"}, {Text: "M"}, {Text: "L"}}},
+	}
+	return product
+}
+
+func (suite *ProductVariationMatrixTestSuite) TestVariationMatrix() {
+	matrix := VariationMatrix(matrixProduct().Options)
+	suite.Len(matrix, 6)
+	suite.Equal([]OptionValue{{Name: "Color", Value: "Red"}, {Name: "Size", Value: "S"}}, matrix[0])
+	suite.Equal([]OptionValue{{Name: "Color", Value: "Blue"}, {Name: "Size", Value: "L"}}, matrix[5])
+
+	suite.Nil(VariationMatrix(nil))
+	suite.Nil(VariationMatrix([]ProductOption{{Type: ProductOptionCheckbox, Name: "Gift"}}))
+}
+
+func (suite *ProductVariationMatrixTestSuite) TestVariationKey() {
+	suite.Equal("Color=Red, Size=S", VariationKey([]OptionValue{{Name: "Size", Value: "S"}, {Name: "Color", Value: "Red"}}))
+	suite.Equal("", VariationKey(nil))
+}
+
+func (suite *ProductVariationMatrixTestSuite) TestProductVariationsSync() {
+	expectedEndpoint := fmt.Sprintf(endpoint+"/products/%d/combinations", storeID, 999)
+
+	var (
+		added   []NewProductVariation
+		deleted []string
+	)
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+
+			switch req.Method {
+			case "GET":
+				suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+				return httpmock.NewStringResponse(200, `[
+					{"id":1,"options":[{"name":"Size","value":"S"},{"name":"Color","value":"Red"}]},
+					{"id":2,"options":[{"name":"Color","value":"Green"},{"name":"Size","value":"S"}]}
+				]`), nil
+			case "POST":
+				suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+				body, err := ioutil.ReadAll(req.Body)
+				suite.Nil(err)
+				var pv NewProductVariation
+				suite.Nil(json.Unmarshal(body, &pv))
+				added = append(added, pv)
+				return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, 100+len(added))), nil
+			case "DELETE":
+				deleted = append(deleted, actualEndpoint)
+				return httpmock.NewStringResponse(200, `{"deleteCount":1}`), nil
+			}
+			return httpmock.NewStringResponse(400, `{"errorMessage":"unexpected"}`), nil
+		})
+
+	report, err := suite.client.ProductVariationsSync(matrixProduct(), &ProductVariationTemplate{
+		Sku:         `{{.Sku}}-{{index .Options "Color"}}-{{index .Options "Size"}}`,
+		Price:       MustParseMoney("9.99"),
+		Quantity:    5,
+		DeleteStale: true,
+	})
+	suite.Nil(err)
+
+	suite.Len(added, 5, "Color=Red, Size=S exists")
+	suite.Equal("TEE-Red-M", added[0].Sku, "sku")
+	suite.Equal("9.99", added[0].Price.String(), "price")
+	suite.Equal(uint(5), added[0].Quantity, "quantity")
+	suite.Len(report.Created, 5)
+	suite.Equal(ID(101), report.Created["Color=Red, Size=M"])
+
+	suite.Equal([]string{expectedEndpoint + "/2"}, deleted)
+	suite.Equal(map[string]ID{"Color=Green, Size=S": 2}, report.Deleted)
+	suite.Empty(report.Failed)
+}
+
+func (suite *ProductVariationMatrixTestSuite) TestProductVariationsSyncKeepStale() {
+	deleted := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			switch req.Method {
+			case "GET":
+				return httpmock.NewStringResponse(200, `[{"id":2,"options":[{"name":"Color","value":"Green"}]}]`), nil
+			case "DELETE":
+				deleted = true
+			}
+			return httpmock.NewStringResponse(500, `{"errorMessage":"failed"}`), nil
+		})
+
+	report, err := suite.client.ProductVariationsSync(matrixProduct(), nil)
+	suite.Nil(err)
+	suite.False(deleted, "stale variation is kept")
+	suite.Len(report.Failed, 6)
+	suite.Empty(report.Created)
+}
+
+func (suite *ProductVariationMatrixTestSuite) TestProductVariationsSyncBadTemplate() {
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+			return httpmock.NewStringResponse(200, `[]`), nil
+		})
+
+	_, err := suite.client.ProductVariationsSync(matrixProduct(), &ProductVariationTemplate{Sku: "{{.Sku"})
+	suite.NotNil(err)
+	suite.False(requested, "no request on invalid template")
+
+	_, err = suite.client.ProductVariationsSync(matrixProduct(), &ProductVariationTemplate{Sku: "{{.Unknown}}"})
+	suite.NotNil(err)
+}
@@ -101,6 +101,7 @@ func (suite *ProductVariationTestSuite) TestProductVariationUpdate() {
 			err = json.Unmarshal(body, &pv)
 			suite.Nil(err)
 			suite.Equal(sku, pv.Sku, "sku")
+			suite.Empty(req.URL.Query().Get("checkLowStockNotification"), "checkLowStockNotification")
 
 			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
 		})
@@ -110,3 +111,168 @@ func (suite *ProductVariationTestSuite) TestProductVariationUpdate() {
 
 	suite.Nil(err)
 }
+
+func (suite *ProductVariationTestSuite) TestProductVariationAdd() {
+	const (
+		productID   ID = 999
+		variationID ID = 555
+		sku            = "test"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/products/%d/combinations", storeID, productID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("POST", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			var pv NewProductVariation
+			err = json.Unmarshal(body, &pv)
+			suite.Nil(err)
+			suite.Equal(sku, pv.Sku, "sku")
+			suite.Equal("Size", pv.Options[0].Name, "options")
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id":%d}`, variationID)), nil
+		})
+
+	id, err := suite.client.ProductVariationAdd(productID, &NewProductVariation{
+		Sku:     sku,
+		Options: []OptionValue{{Name: "Size", Value: "XL"}},
+	})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(variationID, id, "id")
+}
+
+func (suite *ProductVariationTestSuite) TestProductVariationDelete() {
+	const (
+		productID   ID = 999
+		variationID ID = 555
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/products/%d/combinations/%d", storeID, productID, variationID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":1}`), nil
+		})
+
+	err := suite.client.ProductVariationDelete(productID, variationID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
+
+func (suite *ProductVariationTestSuite) TestProductVariationsDelete() {
+	const (
+		productID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/products/%d/combinations", storeID, productID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":12}`), nil
+		})
+
+	count, err := suite.client.ProductVariationsDelete(productID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(uint(12), count, "deleteCount")
+}
+
+func (suite *ProductVariationTestSuite) TestProductVariationInventoryAdjust() {
+	const (
+		productID   ID = 999
+		variationID ID = 555
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/products/%d/combinations/%d/inventory", storeID, productID, variationID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("PUT", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			var d struct {
+				Delta int `json:"quantityDelta"`
+			}
+			err = json.Unmarshal(body, &d)
+			suite.Nil(err)
+			suite.Equal(5, d.Delta, "delta")
+
+			return httpmock.NewStringResponse(200, `{"updateCount":7}`), nil
+		})
+
+	quantity, err := suite.client.ProductVariationInventoryAdjust(productID, variationID, 5)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(7, quantity, "quantity")
+}
+
+func (suite *ProductVariationTestSuite) TestProductVariationUpdateLowStockNotification() {
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("true", req.URL.Query().Get("checkLowStockNotification"), "checkLowStockNotification")
+
+			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
+		})
+
+	err := suite.client.ProductVariationUpdate(999, 555, &NewProductVariation{Quantity: 1}, WithLowStockNotification())
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
+
+func (suite *ProductVariationTestSuite) TestProductVariationInventoryAdjustLowStockNotification() {
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("true", req.URL.Query().Get("checkLowStockNotification"), "checkLowStockNotification")
+
+			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
+		})
+
+	_, err := suite.client.ProductVariationInventoryAdjust(999, 555, -1, WithLowStockNotification())
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
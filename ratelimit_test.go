@@ -0,0 +1,102 @@
+package ecwid
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+func (suite *RateLimitTestSuite) TestWait() {
+	limiter := NewRateLimiter(20, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		suite.Nil(limiter.Wait(ctx))
+	}
+	// 2 by burst, 2 by rate
+	suite.True(time.Since(start) >= 90*time.Millisecond, "must be throttled")
+}
+
+func (suite *RateLimitTestSuite) TestWaitCancel() {
+	limiter := NewRateLimiter(1, 1)
+	suite.Nil(limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	suite.Equal(context.DeadlineExceeded, limiter.Wait(ctx))
+}
+
+func (suite *RateLimitTestSuite) TestSharedLimiter() {
+	limiter := NewRateLimiter(20, 1)
+
+	mock := httpmock.NewMockTransport()
+	mock.RegisterNoResponder(httpmock.NewStringResponder(200, `{}`))
+
+	client1 := New(storeID, token, WithTransport(mock), WithRateLimiter(limiter))
+	client2 := New(storeID, token, WithTransport(mock), WithRateLimiter(limiter))
+
+	start := time.Now()
+	for _, client := range []*Client{client1, client2, client1} {
+		_, err := client.StoreProfileGet()
+		suite.Nil(err)
+	}
+	suite.True(time.Since(start) >= 90*time.Millisecond, "must be throttled")
+	suite.Equal(3, mock.GetTotalCallCount())
+}
+
+func (suite *RateLimitTestSuite) TestSharedLimiterSharedHTTPClient() {
+	// bucket is not refilled during the test, so spent tokens are countable
+	limiter := NewRateLimiter(0.001, 10)
+
+	mock := httpmock.NewMockTransport()
+	mock.RegisterNoResponder(httpmock.NewStringResponder(200, `{}`))
+
+	httpClient := &http.Client{Transport: mock}
+	client1 := New(storeID, token, WithHTTPClient(httpClient), WithRateLimiter(limiter))
+	client2 := New(storeID, token, WithHTTPClient(httpClient), WithRateLimiter(limiter))
+	suite.True(httpClient.Transport == mock, "caller's transport is unchanged")
+
+	for _, client := range []*Client{client1, client2, client1} {
+		_, err := client.StoreProfileGet()
+		suite.Nil(err)
+	}
+	suite.Equal(3, mock.GetTotalCallCount())
+
+	limiter.mu.Lock()
+	spent := limiter.burst - limiter.tokens
+	limiter.mu.Unlock()
+	suite.InDelta(3, spent, 0.1, "one token per request")
+}
+
+func (suite *RateLimitTestSuite) TestLimiterCancel() {
+	limiter := NewRateLimiter(1, 1)
+	suite.Nil(limiter.Wait(context.Background()))
+
+	requested := false
+	mock := httpmock.NewMockTransport()
+	mock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+			return httpmock.NewStringResponse(200, `{}`), nil
+		})
+	client := New(storeID, token, WithTransport(mock), WithRateLimiter(limiter))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.WithContext(ctx).StoreProfileGet()
+	suite.NotNil(err)
+	suite.False(requested, "request must not be sent")
+}
@@ -2,7 +2,6 @@ package ecwid
 
 import (
 	"encoding/json"
-	"errors"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -10,25 +9,44 @@ import (
 func errorResponse(response *resty.Response) error {
 	var result struct {
 		ErrorMessage string `json:"errorMessage"`
+		ErrorCode    string `json:"errorCode"`
 	}
-	if err := json.Unmarshal(response.Body(), &result); err == nil && len(result.ErrorMessage) > 0 {
-		if result.ErrorMessage != "" {
-			return errors.New(result.ErrorMessage)
+	// body may be empty or not a json, errorMessage and errorCode are optional
+	_ = json.Unmarshal(response.Body(), &result)
+
+	apiErr := &APIError{
+		StatusCode:   response.StatusCode(),
+		ErrorMessage: result.ErrorMessage,
+		ErrorCode:    result.ErrorCode,
+	}
+	if request := response.Request; request != nil {
+		apiErr.Method = request.Method
+		if request.RawRequest != nil {
+			apiErr.Path = request.RawRequest.URL.Path
 		}
 	}
-	return errors.New(response.Status())
+	return apiErr
 }
 
-func responseUnmarshal(response *resty.Response, err error, result interface{}) error {
+func responseBody(response *resty.Response, err error) ([]byte, error) {
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if response.StatusCode() != 200 {
-		return errorResponse(response)
+		return nil, errorResponse(response)
+	}
+
+	return response.Body(), nil
+}
+
+func responseUnmarshal(response *resty.Response, err error, result interface{}) error {
+	body, err := responseBody(response, err)
+	if err != nil {
+		return err
 	}
 
-	err = json.Unmarshal(response.Body(), &result)
+	err = json.Unmarshal(body, &result)
 	if err != nil {
 		return err
 	}
@@ -61,7 +79,7 @@ func responseUpdate(response *resty.Response, err error) error {
 		return err
 	}
 	if count != 1 {
-		return errors.New("no updated")
+		return ErrNotUpdated
 	}
 	return nil
 }
@@ -76,7 +94,7 @@ func responseDelete(response *resty.Response, err error) (uint, error) {
 	}
 
 	if result.DeleteCount == 0 {
-		return 0, errors.New("no deleted")
+		return 0, ErrNotDeleted
 	}
 	return result.DeleteCount, nil
 }
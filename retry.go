@@ -0,0 +1,173 @@
+package ecwid
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMinWait = 500 * time.Millisecond
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+type (
+	// RetryPolicy of failed requests.
+	// Requests are retried on HTTP 429, 5xx and network errors
+	// with jittered exponential backoff or after Retry-After if present
+	RetryPolicy struct {
+		MaxRetries int           // 0 disables retries
+		MinWait    time.Duration // backoff base, default 500ms
+		MaxWait    time.Duration // backoff and Retry-After cap, default 30s
+
+		// RetryNonIdempotent allows to retry POST requests.
+		// Requests with stream body (like image uploads) are never retried,
+		// inventory adjust requests are retried only on HTTP 429
+		RetryNonIdempotent bool
+
+		// OnRetry is called before waiting for the next attempt.
+		// attempt starts from 1, resp is nil on network error
+		OnRetry func(attempt int, req *http.Request, resp *http.Response, err error, wait time.Duration)
+	}
+
+	retryTransport struct {
+		next   http.RoundTripper
+		policy RetryPolicy
+	}
+
+	rateLimitedRetryOnlyKey struct{}
+)
+
+// WithRetry sets retry policy of failed requests
+func WithRetry(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = &policy
+	}
+}
+
+// rateLimitedRetryOnly marks requests of ctx as retryable only on HTTP 429.
+// It is used for non-idempotent PUT like inventory adjust by delta,
+// which may be applied by Ecwid before 5xx or network error
+func rateLimitedRetryOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rateLimitedRetryOnlyKey{}, true)
+}
+
+func newRetryTransport(next http.RoundTripper, policy RetryPolicy) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if policy.MinWait <= 0 {
+		policy.MinWait = defaultRetryMinWait
+	}
+	if policy.MaxWait <= 0 {
+		policy.MaxWait = defaultRetryMaxWait
+	}
+	return &retryTransport{
+		next:   next,
+		policy: policy,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	retryable := replayable && (t.policy.RetryNonIdempotent || isIdempotent(req.Method))
+	rateLimitedOnly := req.Context().Value(rateLimitedRetryOnlyKey{}) != nil
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+
+		if !retryable || attempt > t.policy.MaxRetries || !needsRetry(req, resp, err) {
+			return resp, err
+		}
+		if rateLimitedOnly && (resp == nil || resp.StatusCode != http.StatusTooManyRequests) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				wait = retryAfter
+				if wait > t.policy.MaxWait {
+					wait = t.policy.MaxWait
+				}
+			}
+		}
+
+		if t.policy.OnRetry != nil {
+			t.policy.OnRetry(attempt, req, resp, err, wait)
+		}
+
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// backoff returns jittered exponential wait time before attempt+1
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	wait := t.policy.MaxWait
+	if shift := uint(attempt - 1); shift < 32 {
+		if w := t.policy.MinWait << shift; w > 0 && w < wait {
+			wait = w
+		}
+	}
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func needsRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// cancelled or timed out request is not retried
+		return req.Context().Err() == nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses Retry-After header as delay seconds or HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
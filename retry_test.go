@@ -0,0 +1,206 @@
+package ecwid
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RetryTestSuite struct {
+	suite.Suite
+	mock    *httpmock.MockTransport
+	retries []int
+	client  *Client
+}
+
+func TestRetryTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryTestSuite))
+}
+
+func (suite *RetryTestSuite) SetupTest() {
+	suite.mock = httpmock.NewMockTransport()
+	suite.retries = nil
+	suite.client = New(storeID, token,
+		WithTransport(suite.mock),
+		WithRetry(RetryPolicy{
+			MaxRetries: 2,
+			MinWait:    time.Millisecond,
+			MaxWait:    10 * time.Millisecond,
+			OnRetry: func(attempt int, req *http.Request, resp *http.Response, err error, wait time.Duration) {
+				suite.retries = append(suite.retries, attempt)
+			},
+		}),
+	)
+}
+
+func (suite *RetryTestSuite) TestRetryRateLimited() {
+	requestCount := 0
+
+	suite.mock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			if requestCount < 3 {
+				resp := httpmock.NewStringResponse(429, "")
+				resp.Header.Set("Retry-After", "0")
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(200, `{"id":1}`), nil
+		})
+
+	p, err := suite.client.ProductGet(1)
+	suite.Nil(err)
+	suite.Equal(ID(1), p.ID)
+	suite.Equal(3, requestCount, "request count")
+	suite.Equal([]int{1, 2}, suite.retries, "OnRetry attempts")
+}
+
+func (suite *RetryTestSuite) TestRetryExhausted() {
+	requestCount := 0
+
+	suite.mock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requestCount++
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			suite.Contains(string(body), `"sku":"sku"`, "body must be replayed")
+
+			return httpmock.NewStringResponse(503, ""), nil
+		})
+
+	err := suite.client.ProductUpdate(1, &NewProduct{Sku: "sku"})
+	suite.NotNil(err)
+	suite.Equal(3, requestCount, "request count")
+
+	var apiErr *APIError
+	suite.True(errors.As(err, &apiErr))
+	suite.Equal(503, apiErr.StatusCode)
+}
+
+func (suite *RetryTestSuite) TestNoRetryNonIdempotent() {
+	requestCount := 0
+
+	suite.mock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			return httpmock.NewStringResponse(429, ""), nil
+		})
+
+	_, err := suite.client.ProductAdd(&NewProduct{Sku: "sku"})
+	suite.True(errors.Is(err, ErrRateLimited))
+	suite.Equal(1, requestCount, "request count")
+	suite.Empty(suite.retries)
+}
+
+func (suite *RetryTestSuite) TestNoRetryInventoryAdjust() {
+	requestCount := 0
+
+	suite.mock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			return httpmock.NewStringResponse(503, ""), nil
+		})
+
+	_, err := suite.client.ProductInventoryAdjust(1, -1)
+	suite.NotNil(err)
+	suite.Equal(1, requestCount, "delta must not be applied twice")
+	suite.Empty(suite.retries)
+}
+
+func (suite *RetryTestSuite) TestNoRetryVariationInventoryAdjust() {
+	requestCount := 0
+
+	suite.mock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			return httpmock.NewStringResponse(503, ""), nil
+		})
+
+	_, err := suite.client.ProductVariationInventoryAdjust(1, 2, -1)
+	suite.NotNil(err)
+	suite.Equal(1, requestCount, "delta must not be applied twice")
+	suite.Empty(suite.retries)
+}
+
+func (suite *RetryTestSuite) TestRetryInventoryAdjustRateLimited() {
+	requestCount := 0
+
+	suite.mock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			if requestCount < 2 {
+				return httpmock.NewStringResponse(429, ""), nil
+			}
+			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
+		})
+
+	_, err := suite.client.ProductInventoryAdjust(1, -1)
+	suite.Nil(err)
+	suite.Equal(2, requestCount, "rate limited request is not applied")
+}
+
+func (suite *RetryTestSuite) TestNoRetryCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	requestCount := 0
+
+	suite.mock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			cancel()
+			return nil, req.Context().Err()
+		})
+
+	_, err := suite.client.WithContext(ctx).ProductGet(1)
+	suite.True(errors.Is(err, context.Canceled), "%v", err)
+	suite.Equal(1, requestCount, "request count")
+	suite.Empty(suite.retries, "OnRetry must not be called")
+}
+
+func (suite *RetryTestSuite) TestSharedHTTPClient() {
+	requestCount := 0
+
+	mock := httpmock.NewMockTransport()
+	mock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requestCount++
+			return httpmock.NewStringResponse(503, ""), nil
+		})
+
+	httpClient := &http.Client{Transport: mock}
+	policy := RetryPolicy{
+		MaxRetries: 2,
+		MinWait:    time.Millisecond,
+		MaxWait:    10 * time.Millisecond,
+	}
+	client := New(storeID, token, WithHTTPClient(httpClient), WithRetry(policy))
+	New(storeID, token, WithHTTPClient(httpClient), WithRetry(policy))
+
+	_, err := client.ProductGet(1)
+	suite.NotNil(err)
+	suite.Equal(3, requestCount, "retry transports must not stack")
+	suite.True(httpClient.Transport == mock, "caller's transport is unchanged")
+}
+
+func (suite *RetryTestSuite) TestParseRetryAfter() {
+	wait, ok := parseRetryAfter("")
+	suite.False(ok)
+
+	wait, ok = parseRetryAfter("5")
+	suite.True(ok)
+	suite.Equal(5*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	suite.True(ok)
+	suite.Equal(time.Duration(0), wait)
+
+	_, ok = parseRetryAfter("soon")
+	suite.False(ok)
+}
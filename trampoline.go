@@ -1,96 +1,97 @@
 package ecwid
 
-import "fmt"
-
-// closure hell instead of generics
-
-type searchTrampoliner func(map[string]string, uint) (*SearchResponse, error)
-
-func searchTrampoline(filter map[string]string, trampoliner searchTrampoliner) error {
-	filterCopy := make(map[string]string)
-	for k, v := range filter {
-		filterCopy[k] = v
+import "context"
+
+// searchTrampoline calls fn on each found item.
+// Error is wrapped into *PaginationError to resume from the failed item
+func searchTrampoline[T any](ctx context.Context, prefetch int, filter map[string]string, fetch pageFetcher[T], fn func(uint, T) error) error {
+	processed := uint(0)
+	counter := func(index uint, item T) error {
+		if err := fn(index, item); err != nil {
+			return err
+		}
+		processed = index + 1
+		return nil
 	}
 
-	index := uint(0)
+	var err error
+	if prefetch > 1 {
+		err = prefetchTrampoline(ctx, prefetch, filter, fetch, counter)
+	} else {
+		err = iteratorTrampoline(ctx, filter, fetch, counter)
+	}
 
-	for {
-		resp, err := trampoliner(filterCopy, index)
-		if err != nil {
-			return err
+	if err != nil {
+		return &PaginationError{
+			Cursor: newCursor(filter, filterOffset(filter)+processed),
+			Err:    err,
 		}
+	}
+	return nil
+}
 
-		index += resp.Count
+func iteratorTrampoline[T any](ctx context.Context, filter map[string]string, fetch pageFetcher[T], fn func(uint, T) error) error {
+	it := newIterator(filter, fetch)
 
-		if resp.Offset+resp.Count >= resp.Total {
-			return nil
+	for index := uint(0); it.Next(ctx); index++ {
+		if err := fn(index, it.Item()); err != nil {
+			return err
 		}
-		filterCopy["offset"] = fmt.Sprintf("%d", resp.Offset+resp.Count)
 	}
+
+	return it.Err()
 }
 
 // ////////////////////////////////////////////////////////////////////////////
 
-// ProductsTrampoline call on each product
+// ProductsTrampoline call on each product.
+// Stops with the context error when the client context ends.
+// See WithPrefetch for parallel page fetching and PaginationError to resume
 func (c *Client) ProductsTrampoline(filter map[string]string, fn func(uint, *Product) error) error {
-
-	return searchTrampoline(filter, func(filter map[string]string, index uint) (*SearchResponse, error) {
-		resp, err := c.ProductsSearch(filter)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, item := range resp.Items {
-			if err := fn(index, item); err != nil {
-				return nil, err
-			}
-			index++
-		}
-
-		return &resp.SearchResponse, nil
-	})
+	return searchTrampoline(c.Context(), c.prefetch, filter, c.productsPage, fn)
 }
 
 // ////////////////////////////////////////////////////////////////////////////
 
-// CategoriesTrampoline call on each category
+// CategoriesTrampoline call on each category.
+// Stops with the context error when the client context ends.
+// See WithPrefetch for parallel page fetching and PaginationError to resume
 func (c *Client) CategoriesTrampoline(filter map[string]string, fn func(uint, *Category) error) error {
+	return searchTrampoline(c.Context(), c.prefetch, filter, c.categoriesPage, fn)
+}
 
-	return searchTrampoline(filter, func(filter map[string]string, index uint) (*SearchResponse, error) {
-		resp, err := c.CategoriesSearch(filter)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, item := range resp.Items {
-			if err := fn(index, item); err != nil {
-				return nil, err
-			}
-			index++
-		}
+// ////////////////////////////////////////////////////////////////////////////
 
-		return &resp.SearchResponse, nil
-	})
+// OrdersTrampoline call on each order.
+// Stops with the context error when the client context ends.
+// See WithPrefetch for parallel page fetching and PaginationError to resume
+func (c *Client) OrdersTrampoline(filter map[string]string, fn func(uint, *Order) error) error {
+	return searchTrampoline(c.Context(), c.prefetch, filter, c.ordersPage, fn)
 }
 
 // ////////////////////////////////////////////////////////////////////////////
 
-// OrdersTrampoline call on each order
-func (c *Client) OrdersTrampoline(filter map[string]string, fn func(uint, *Order) error) error {
+// CustomersTrampoline call on each customer.
+// Stops with the context error when the client context ends.
+// See WithPrefetch for parallel page fetching and PaginationError to resume
+func (c *Client) CustomersTrampoline(filter map[string]string, fn func(uint, *Customer) error) error {
+	return searchTrampoline(c.Context(), c.prefetch, filter, c.customersPage, fn)
+}
 
-	return searchTrampoline(filter, func(filter map[string]string, index uint) (*SearchResponse, error) {
-		resp, err := c.OrdersSearch(filter)
-		if err != nil {
-			return nil, err
-		}
+// ////////////////////////////////////////////////////////////////////////////
 
-		for _, item := range resp.Items {
-			if err := fn(index, item); err != nil {
-				return nil, err
-			}
-			index++
-		}
+// DiscountCouponsTrampoline call on each discount coupon.
+// Stops with the context error when the client context ends.
+// See WithPrefetch for parallel page fetching and PaginationError to resume
+func (c *Client) DiscountCouponsTrampoline(filter map[string]string, fn func(uint, *DiscountCoupon) error) error {
+	return searchTrampoline(c.Context(), c.prefetch, filter, c.discountCouponsPage, fn)
+}
+
+// ////////////////////////////////////////////////////////////////////////////
 
-		return &resp.SearchResponse, nil
-	})
+// UnfinishedOrdersTrampoline call on each unfinished order.
+// Stops with the context error when the client context ends.
+// See WithPrefetch for parallel page fetching and PaginationError to resume
+func (c *Client) UnfinishedOrdersTrampoline(filter map[string]string, fn func(uint, *Order) error) error {
+	return searchTrampoline(c.Context(), c.prefetch, filter, c.unfinishedOrdersPage, fn)
 }
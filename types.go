@@ -1,6 +1,10 @@
 package ecwid
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
 
 type (
 
@@ -18,16 +22,21 @@ type (
 	// Like a NULL if zero or negative.
 	ID uint64
 
-	// DateTime some like "2015-09-20 19:59:43 +0000"
-	DateTime string // TODO DateTime => time.Date + Marshal|Unmarshal|String
+	// DateTime some like "2015-09-20 19:59:43 +0000".
+	// Zero DateTime is marshaled as ""
+	DateTime time.Time
 
-	// Timestamp is unix timestamp
-	Timestamp uint64 // TODO Timestamp => time.Date + Marshal|Unmarshal|String
+	// Timestamp is unix timestamp.
+	// Zero Timestamp is marshaled as 0
+	Timestamp time.Time
 
 	// ModifierType - price modifier type
 	ModifierType string
 )
 
+// dateTimeLayout is DateTime format
+const dateTimeLayout = "2006-01-02 15:04:05 -0700"
+
 // ModifierType types
 const (
 	ModifierPercent  ModifierType = "PERCENT"
@@ -48,3 +57,86 @@ func (id *ID) UnmarshalJSON(data []byte) error {
 	*id = ID(i)
 	return nil
 }
+
+// Time returns DateTime as time.Time
+func (dt DateTime) Time() time.Time {
+	return time.Time(dt)
+}
+
+// IsZero reports whether dt is zero
+func (dt DateTime) IsZero() bool {
+	return time.Time(dt).IsZero()
+}
+
+// String formats DateTime like "2015-09-20 19:59:43 +0000", zero as ""
+func (dt DateTime) String() string {
+	if dt.IsZero() {
+		return ""
+	}
+	return time.Time(dt).Format(dateTimeLayout)
+}
+
+// MarshalJSON marshal as "2015-09-20 19:59:43 +0000"
+func (dt DateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dt.String())
+}
+
+// UnmarshalJSON unmarshal "2015-09-20 19:59:43 +0000", "" or null
+func (dt *DateTime) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		*dt = DateTime{}
+		return nil
+	}
+	t, err := time.Parse(dateTimeLayout, *s)
+	if err != nil {
+		return err
+	}
+	*dt = DateTime(t)
+	return nil
+}
+
+// Time returns Timestamp as time.Time
+func (ts Timestamp) Time() time.Time {
+	return time.Time(ts)
+}
+
+// IsZero reports whether ts is zero
+func (ts Timestamp) IsZero() bool {
+	return time.Time(ts).IsZero()
+}
+
+// Unix returns unix seconds, 0 if zero
+func (ts Timestamp) Unix() int64 {
+	if ts.IsZero() {
+		return 0
+	}
+	return time.Time(ts).Unix()
+}
+
+// String formats Timestamp as unix seconds
+func (ts Timestamp) String() string {
+	return strconv.FormatInt(ts.Unix(), 10)
+}
+
+// MarshalJSON marshal as unix seconds
+func (ts Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte(ts.String()), nil
+}
+
+// UnmarshalJSON unmarshal unix seconds, 0 or null as zero
+func (ts *Timestamp) UnmarshalJSON(data []byte) error {
+	var unix *int64
+	if err := json.Unmarshal(data, &unix); err != nil {
+		return err
+	}
+	if unix == nil || *unix == 0 {
+		*ts = Timestamp{}
+		return nil
+	}
+	*ts = Timestamp(time.Unix(*unix, 0).UTC())
+	return nil
+}
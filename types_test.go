@@ -3,6 +3,7 @@ package ecwid
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -48,3 +49,62 @@ func (suite *TypesTestSuite) TestIDMashalJSON() {
 	suite.Nil(err)
 	suite.Equal(`{"id":1}`, string(data))
 }
+
+func (suite *TypesTestSuite) TestDateTimeJSON() {
+	var v struct {
+		DateTime DateTime `json:"dt"`
+	}
+
+	err := json.Unmarshal([]byte(`{"dt":"2015-09-20 19:59:43 +0000"}`), &v)
+	suite.Nil(err)
+	suite.True(time.Date(2015, 9, 20, 19, 59, 43, 0, time.UTC).Equal(v.DateTime.Time()))
+	suite.Equal("2015-09-20 19:59:43 +0000", v.DateTime.String())
+
+	data, err := json.Marshal(&v)
+	suite.Nil(err)
+	suite.Equal(`{"dt":"2015-09-20 19:59:43 +0000"}`, string(data))
+
+	for _, empty := range []string{`{"dt":""}`, `{"dt":null}`} {
+		err = json.Unmarshal([]byte(empty), &v)
+		suite.Nil(err)
+		suite.True(v.DateTime.IsZero(), empty)
+	}
+
+	data, err = json.Marshal(&v)
+	suite.Nil(err)
+	suite.Equal(`{"dt":""}`, string(data))
+
+	err = json.Unmarshal([]byte(`{"dt":"yesterday"}`), &v)
+	suite.NotNil(err)
+}
+
+func (suite *TypesTestSuite) TestDateTimeOmitZero() {
+	data, err := json.Marshal(&NewProduct{})
+	suite.Nil(err)
+	suite.NotContains(string(data), `"created"`)
+}
+
+func (suite *TypesTestSuite) TestTimestampJSON() {
+	var v struct {
+		Timestamp Timestamp `json:"ts"`
+	}
+
+	err := json.Unmarshal([]byte(`{"ts":1442779183}`), &v)
+	suite.Nil(err)
+	suite.True(time.Date(2015, 9, 20, 19, 59, 43, 0, time.UTC).Equal(v.Timestamp.Time()))
+	suite.Equal("1442779183", v.Timestamp.String())
+
+	data, err := json.Marshal(&v)
+	suite.Nil(err)
+	suite.Equal(`{"ts":1442779183}`, string(data))
+
+	for _, empty := range []string{`{"ts":0}`, `{"ts":null}`} {
+		err = json.Unmarshal([]byte(empty), &v)
+		suite.Nil(err)
+		suite.True(v.Timestamp.IsZero(), empty)
+	}
+
+	data, err = json.Marshal(&v)
+	suite.Nil(err)
+	suite.Equal(`{"ts":0}`, string(data))
+}
@@ -0,0 +1,74 @@
+package ecwid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+type (
+	// UnfinishedOrdersSearchResponse https://developers.ecwid.com/api-documentation/unfinished-orders#search-unfinished-orders
+	UnfinishedOrdersSearchResponse struct {
+		SearchResponse
+		Items []*Order `json:"items"`
+	}
+)
+
+// UnfinishedOrdersSearch search or filter unfinished orders (abandoned carts) in a store
+// filter:
+// keywords customerId createdFrom createdTo updatedFrom updatedTo
+// totalFrom totalTo email offset limit
+func (c *Client) UnfinishedOrdersSearch(filter map[string]string) (*UnfinishedOrdersSearchResponse, error) {
+	response, err := c.R().
+		SetQueryParams(filter).
+		Get("/unfinished_orders")
+
+	var result UnfinishedOrdersSearchResponse
+	return &result, responseUnmarshal(response, err, &result)
+}
+
+// UnfinishedOrdersIterator iterates by filtered store unfinished orders
+func (c *Client) UnfinishedOrdersIterator(filter map[string]string) *Iterator[*Order] {
+	return newIterator(filter, c.unfinishedOrdersPage)
+}
+
+// UnfinishedOrdersSeq iterates by filtered store unfinished orders with range-over-func
+func (c *Client) UnfinishedOrdersSeq(ctx context.Context, filter map[string]string) iter.Seq2[*Order, error] {
+	return c.UnfinishedOrdersIterator(filter).Seq(ctx)
+}
+
+func (c *Client) unfinishedOrdersPage(ctx context.Context, filter map[string]string) ([]*Order, *SearchResponse, error) {
+	resp, err := c.WithContext(ctx).UnfinishedOrdersSearch(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Items, &resp.SearchResponse, nil
+}
+
+// UnfinishedOrderGet gets all details of a specific unfinished order in an Ecwid store by its ID
+func (c *Client) UnfinishedOrderGet(orderID ID) (*Order, error) {
+	response, err := c.R().
+		Get(fmt.Sprintf("/unfinished_orders/%d", orderID))
+
+	var result Order
+	return &result, responseUnmarshal(response, err, &result)
+}
+
+// UnfinishedOrderUpdate update an existing unfinished order in an Ecwid store referring to its ID
+func (c *Client) UnfinishedOrderUpdate(orderID ID, order *NewOrder) error {
+	response, err := c.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(order).
+		Put(fmt.Sprintf("/unfinished_orders/%d", orderID))
+
+	return responseUpdate(response, err)
+}
+
+// UnfinishedOrderDelete delete an unfinished order from an Ecwid store referring to its ID
+func (c *Client) UnfinishedOrderDelete(orderID ID) error {
+	response, err := c.R().
+		Delete(fmt.Sprintf("/unfinished_orders/%d", orderID))
+
+	_, err = responseDelete(response, err)
+	return err
+}
@@ -0,0 +1,152 @@
+package ecwid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnfinishedOrderTestSuite struct {
+	ClientTestSuite
+}
+
+func TestUnfinishedOrderTestSuite(t *testing.T) {
+	suite.Run(t, new(UnfinishedOrderTestSuite))
+}
+
+func (suite *UnfinishedOrderTestSuite) TestUnfinishedOrdersSearch() {
+	expectedEndpoint := fmt.Sprintf(endpoint+"/unfinished_orders", storeID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("GET", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("42", req.URL.Query().Get("customerId"), "customerId")
+
+			return httpmock.NewStringResponse(200, `{"total":1,"count":1,"items":[{"orderNumber":7,"email":"john@example.com","items":[{"productId":3,"quantity":2}]}]}`), nil
+		})
+
+	resp, err := suite.client.UnfinishedOrdersSearch(map[string]string{"customerId": "42"})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(ID(7), resp.Items[0].OrderID, "orderNumber")
+	suite.Equal("john@example.com", resp.Items[0].Email, "email")
+	suite.Equal(ID(3), resp.Items[0].Items[0].ProductID, "item")
+}
+
+func (suite *UnfinishedOrderTestSuite) TestUnfinishedOrdersSeq() {
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			offset := req.URL.Query().Get("offset")
+			if offset == "" {
+				offset = "0"
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"total":2,"count":1,"offset":%s,"items":[{"orderNumber":%s}]}`, offset, offset)), nil
+		})
+
+	ids := make([]ID, 0, 2)
+	for order, err := range suite.client.UnfinishedOrdersSeq(context.Background(), nil) {
+		suite.Nil(err)
+		ids = append(ids, order.OrderID)
+	}
+	suite.Equal([]ID{0, 1}, ids)
+}
+
+func (suite *UnfinishedOrderTestSuite) TestUnfinishedOrderGet() {
+	const (
+		orderID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/unfinished_orders/%d", storeID, orderID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("GET", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"orderNumber":%d,"email":"john@example.com","total":10.5}`, orderID)), nil
+		})
+
+	order, err := suite.client.UnfinishedOrderGet(orderID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+	suite.Equal(orderID, order.OrderID, "orderNumber")
+	suite.Equal("john@example.com", order.Email, "email")
+	suite.Equal("10.5", order.Total.String(), "total")
+}
+
+func (suite *UnfinishedOrderTestSuite) TestUnfinishedOrderUpdate() {
+	const (
+		orderID ID = 999
+		email      = "john@example.com"
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/unfinished_orders/%d", storeID, orderID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("PUT", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+			suite.Equal("application/json", req.Header["Content-Type"][0], "Content-Type: application/json")
+
+			body, err := ioutil.ReadAll(req.Body)
+			suite.Nil(err)
+			var o NewOrder
+			err = json.Unmarshal(body, &o)
+			suite.Nil(err)
+			suite.Equal(email, o.Email, "email")
+
+			return httpmock.NewStringResponse(200, `{"updateCount":1}`), nil
+		})
+
+	err := suite.client.UnfinishedOrderUpdate(orderID, &NewOrder{Email: email})
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
+
+func (suite *UnfinishedOrderTestSuite) TestUnfinishedOrderDelete() {
+	const (
+		orderID ID = 999
+	)
+
+	expectedEndpoint := fmt.Sprintf(endpoint+"/unfinished_orders/%d", storeID, orderID)
+	requested := false
+
+	httpmock.RegisterNoResponder(
+		func(req *http.Request) (*http.Response, error) {
+			requested = true
+
+			suite.Equal("DELETE", req.Method, "request method")
+			actualEndpoint := strings.Split(req.URL.String(), "?")[0]
+			suite.Equal(expectedEndpoint, actualEndpoint, "endpoint")
+
+			return httpmock.NewStringResponse(200, `{"deleteCount":1}`), nil
+		})
+
+	err := suite.client.UnfinishedOrderDelete(orderID)
+	suite.Truef(requested, "request failed")
+
+	suite.Nil(err)
+}
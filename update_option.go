@@ -0,0 +1,34 @@
+package ecwid
+
+import "github.com/go-resty/resty/v2"
+
+type (
+	// UpdateOption configures product and variation stock updates
+	UpdateOption func(*updateOptions)
+
+	updateOptions struct {
+		checkLowStockNotification bool
+	}
+)
+
+// WithLowStockNotification asks Ecwid to compare the new stock with WarningLimit
+// and to send the merchant a low stock email when it is reached
+func WithLowStockNotification() UpdateOption {
+	return func(o *updateOptions) {
+		o.checkLowStockNotification = true
+	}
+}
+
+// updateR creates request with applied update options
+func (c *Client) updateR(opts []UpdateOption) *resty.Request {
+	var o updateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	request := c.R()
+	if o.checkLowStockNotification {
+		request.SetQueryParam("checkLowStockNotification", "true")
+	}
+	return request
+}
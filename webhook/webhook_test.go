@@ -44,7 +44,7 @@ func (suite *WebhookTestSuite) SetupTest() {
 
 	// TODO chech it
 	mac := hmac.New(sha256.New, []byte(secret))
-	mac.Write([]byte(fmt.Sprintf("%d.%s", body.Created, body.ID)))
+	mac.Write([]byte(fmt.Sprintf("%d.%s", body.Created.Unix(), body.ID)))
 	sig := base64.StdEncoding.EncodeToString(mac.Sum(nil))
 	suite.r.Header.Add("X-Ecwid-Webhook-Signature", sig)
 